@@ -0,0 +1,162 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Array represents PostgreSQL uuid[] value. It implements the sql.Scanner
+// and driver.Valuer interfaces using PostgreSQL array text format,
+// e.g. "{6ba7b810-9dad-11d1-80b4-00c04fd430c8,...}". NULL array is
+// scanned as nil slice, while array with NULL elements can only be
+// scanned into NullArray.
+type Array []UUID
+
+// Value implements the driver.Valuer interface.
+// Nil slice is stored as NULL.
+func (a Array) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	buf := make([]byte, 0, 2+len(a)*37)
+	buf = append(buf, '{')
+	for i, u := range a {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = u.AppendString(buf)
+	}
+	buf = append(buf, '}')
+
+	return string(buf), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *Array) Scan(src interface{}) error {
+	elems, err := scanArray(src)
+	if err != nil {
+		return err
+	}
+	if elems == nil {
+		*a = nil
+		return nil
+	}
+
+	arr := make(Array, len(elems))
+	for i, e := range elems {
+		if !e.Valid {
+			return fmt.Errorf("uuid: cannot scan NULL array element %d into Array", i)
+		}
+		arr[i] = e.UUID
+	}
+	*a = arr
+
+	return nil
+}
+
+// NullArray represents PostgreSQL uuid[] value that may contain NULL
+// elements. It implements the sql.Scanner and driver.Valuer interfaces
+// using PostgreSQL array text format.
+type NullArray []NullUUID
+
+// Value implements the driver.Valuer interface.
+// Nil slice is stored as NULL.
+func (a NullArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+
+	buf := make([]byte, 0, 2+len(a)*37)
+	buf = append(buf, '{')
+	for i, u := range a {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		if u.Valid {
+			buf = u.UUID.AppendString(buf)
+		} else {
+			buf = append(buf, "NULL"...)
+		}
+	}
+	buf = append(buf, '}')
+
+	return string(buf), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (a *NullArray) Scan(src interface{}) error {
+	elems, err := scanArray(src)
+	if err != nil {
+		return err
+	}
+	*a = elems
+
+	return nil
+}
+
+// scanArray decodes one-dimensional PostgreSQL array text representation.
+// It returns nil slice for NULL source.
+func scanArray(src interface{}) ([]NullUUID, error) {
+	var text string
+	switch src := src.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	default:
+		return nil, fmt.Errorf("%w: cannot convert %T to UUID array", ErrUnsupportedType, src)
+	}
+
+	if len(text) < 2 || text[0] != '{' || text[len(text)-1] != '}' {
+		return nil, fmt.Errorf("uuid: invalid array format %q", text)
+	}
+
+	body := strings.TrimSpace(text[1 : len(text)-1])
+	if body == "" {
+		return []NullUUID{}, nil
+	}
+
+	fields := strings.Split(body, ",")
+	elems := make([]NullUUID, len(fields))
+	for i, field := range fields {
+		field = strings.TrimSpace(field)
+		if strings.EqualFold(field, "NULL") {
+			continue
+		}
+		if len(field) >= 2 && field[0] == '"' && field[len(field)-1] == '"' {
+			field = field[1 : len(field)-1]
+		}
+		// Element is scanned as string, so that 16 characters long
+		// element is not mistaken for binary representation.
+		if err := elems[i].Scan(field); err != nil {
+			return nil, fmt.Errorf("uuid: invalid array element %d: %w", i, err)
+		}
+	}
+
+	return elems, nil
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type arrayTestSuite struct{}
+
+var _ = Suite(&arrayTestSuite{})
+
+func (s *arrayTestSuite) TestArrayValue(c *C) {
+	val, err := Array(nil).Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, IsNil)
+
+	val, err = Array{}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "{}")
+
+	val, err = Array{NamespaceDNS, NamespaceURL}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8,6ba7b811-9dad-11d1-80b4-00c04fd430c8}")
+}
+
+func (s *arrayTestSuite) TestArrayScan(c *C) {
+	tests := []struct {
+		src interface{}
+		a   Array
+	}{
+		{nil, nil},
+		{"{}", Array{}},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", Array{NamespaceDNS}},
+		{[]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8,6ba7b811-9dad-11d1-80b4-00c04fd430c8}"), Array{NamespaceDNS, NamespaceURL}},
+		{`{ "6ba7b810-9dad-11d1-80b4-00c04fd430c8" , 6ba7b811-9dad-11d1-80b4-00c04fd430c8 }`, Array{NamespaceDNS, NamespaceURL}},
+	}
+	for _, t := range tests {
+		a := Array{NamespaceOID}
+		err := a.Scan(t.src)
+		c.Assert(err, IsNil, Commentf("%v", t.src))
+		c.Assert(a, DeepEquals, t.a, Commentf("%v", t.src))
+	}
+
+	invalid := []interface{}{
+		"",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{invalid}",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8,}",
+		"{0123456789abcdef}",
+		`{"NULL"}`,
+		"{NULL}",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8,null}",
+		42,
+	}
+	for _, src := range invalid {
+		a := Array{NamespaceOID}
+		err := a.Scan(src)
+		c.Assert(err, NotNil, Commentf("%v", src))
+		c.Assert(a, DeepEquals, Array{NamespaceOID})
+	}
+
+	a := Array{}
+	err := a.Scan("{6ba7b810-9dad-11d1-80b4-00c04fd430c8,invalid}")
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+	c.Assert(err, ErrorMatches, "uuid: invalid array element 1: .*")
+
+	err = a.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+}
+
+func (s *arrayTestSuite) TestNullArrayValue(c *C) {
+	val, err := NullArray(nil).Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, IsNil)
+
+	val, err = NullArray{}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "{}")
+
+	val, err = NullArray{{UUID: NamespaceDNS, Valid: true}, {}}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8,NULL}")
+}
+
+func (s *arrayTestSuite) TestNullArrayScan(c *C) {
+	tests := []struct {
+		src interface{}
+		a   NullArray
+	}{
+		{nil, nil},
+		{"{}", NullArray{}},
+		{"{NULL}", NullArray{{}}},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8,null}", NullArray{{UUID: NamespaceDNS, Valid: true}, {}}},
+		{[]byte("{NULL,6ba7b811-9dad-11d1-80b4-00c04fd430c8}"), NullArray{{}, {UUID: NamespaceURL, Valid: true}}},
+	}
+	for _, t := range tests {
+		a := NullArray{{}}
+		err := a.Scan(t.src)
+		c.Assert(err, IsNil, Commentf("%v", t.src))
+		c.Assert(a, DeepEquals, t.a, Commentf("%v", t.src))
+	}
+
+	invalid := []interface{}{"{", "{invalid}", `{"NULL"}`, 42}
+	for _, src := range invalid {
+		a := NullArray{}
+		err := a.Scan(src)
+		c.Assert(err, NotNil, Commentf("%v", src))
+	}
+}
+
+func (s *arrayTestSuite) TestRoundTrip(c *C) {
+	in := NullArray{{UUID: NamespaceDNS, Valid: true}, {}, {UUID: Nil, Valid: true}}
+	val, err := in.Value()
+	c.Assert(err, IsNil)
+
+	var out NullArray
+	err = out.Scan(val)
+	c.Assert(err, IsNil)
+	c.Assert(out, DeepEquals, in)
+}
@@ -0,0 +1,132 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Crockford's Base32 alphabet, sorted in ASCII order.
+const base32Alphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// Length of Crockford's Base32 representation of UUID.
+const base32Len = 26
+
+// base32Table maps Crockford's Base32 characters to their values and
+// any other byte to 0xff. Decoding is case-insensitive, 'I' and 'L' are
+// decoded as '1' and 'O' is decoded as '0'.
+var base32Table = func() (t [256]byte) {
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := 0; i < len(base32Alphabet); i++ {
+		c := base32Alphabet[i]
+		t[c] = byte(i)
+		if 'A' <= c && c <= 'Z' {
+			t[c+('a'-'A')] = byte(i)
+		}
+	}
+	t['I'], t['i'], t['L'], t['l'] = 1, 1, 1, 1
+	t['O'], t['o'] = 0, 0
+	return
+}()
+
+// Base32 returns Crockford's Base32 representation of UUID:
+// 26 upper-case characters, e.g. "3BMYW117DD278R1D00R17X8C68".
+// Encoding preserves byte order, so representations of UUIDs sort
+// the same way as UUIDs themselves.
+func (u UUID) Base32() string {
+	var buf [base32Len]byte
+
+	hi, lo := u.uint128()
+	for i := base32Len - 1; i >= 0; i-- {
+		buf[i] = base32Alphabet[lo&0x1f]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+
+	return string(buf[:])
+}
+
+// FromBase32 returns UUID parsed from Crockford's Base32 representation.
+// Decoding is case-insensitive. Malformed input, including values
+// exceeding 128 bits, is reported as *ParseError.
+func FromBase32(input string) (UUID, error) {
+	if len(input) != base32Len {
+		return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(input); i++ {
+		v := base32Table[input[i]]
+		if v == 0xff {
+			return Nil, &ParseError{Input: input, Offset: i, Reason: ReasonCharacter}
+		}
+		if hi>>59 != 0 {
+			return Nil, &ParseError{Input: input, Offset: 0, Reason: ReasonFormat}
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+
+	return fromUint128(hi, lo), nil
+}
+
+// Base32UUID wraps UUID to be marshaled as text and stored in
+// database in Crockford's Base32 representation.
+type Base32UUID struct {
+	UUID UUID
+}
+
+// String returns Crockford's Base32 representation of UUID.
+func (u Base32UUID) String() string {
+	return u.UUID.Base32()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u Base32UUID) MarshalText() ([]byte, error) {
+	return []byte(u.UUID.Base32()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *Base32UUID) UnmarshalText(text []byte) (err error) {
+	u.UUID, err = FromBase32(string(text))
+	return
+}
+
+// Value implements the driver.Valuer interface.
+func (u Base32UUID) Value() (driver.Value, error) {
+	return u.UUID.Base32(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *Base32UUID) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return u.UnmarshalText(src)
+	case string:
+		return u.UnmarshalText([]byte(src))
+	}
+
+	return fmt.Errorf("%w: cannot convert %T to UUID", ErrUnsupportedType, src)
+}
@@ -0,0 +1,148 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type base32TestSuite struct{}
+
+var _ = Suite(&base32TestSuite{})
+
+func (s *base32TestSuite) TestBase32(c *C) {
+	c.Assert(NamespaceDNS.Base32(), Equals, "3BMYW117DD278R1D00R17X8C68")
+	c.Assert(Nil.Base32(), Equals, "00000000000000000000000000")
+	c.Assert(Max.Base32(), Equals, "7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+}
+
+func (s *base32TestSuite) TestFromBase32(c *C) {
+	inputs := []string{
+		"3BMYW117DD278R1D00R17X8C68",
+		"3bmyw117dd278r1d00r17x8c68",
+		"3BMYWIL7DD278R1DOOR17X8C68",
+	}
+	for _, input := range inputs {
+		u, err := FromBase32(input)
+		c.Assert(err, IsNil)
+		c.Assert(u, Equals, NamespaceDNS)
+	}
+
+	u, err := FromBase32("7ZZZZZZZZZZZZZZZZZZZZZZZZZ")
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, Max)
+
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonLength},
+		{"3BMYW117DD278R1D00R17X8C6", 25, ReasonLength},
+		{"3BMYW117DD278R1D00R17X8C688", 27, ReasonLength},
+		{"3BMYW117DD278R1D00R17X8C6U", 25, ReasonCharacter},
+		{"3BMYW117DD278R1D00R1-X8C68", 20, ReasonCharacter},
+		{"80000000000000000000000000", 0, ReasonFormat},
+		{"ZZZZZZZZZZZZZZZZZZZZZZZZZZ", 0, ReasonFormat},
+	}
+	for _, t := range tests {
+		u, err := FromBase32(t.input)
+		c.Assert(u, Equals, Nil)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
+
+func (s *base32TestSuite) TestBase32RoundTrip(c *C) {
+	for i := 0; i < 1000; i++ {
+		u, err := NewV4()
+		c.Assert(err, IsNil)
+
+		u1, err := FromBase32(u.Base32())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *base32TestSuite) TestBase32Ordering(c *C) {
+	uuids := make([]UUID, 1000)
+	encoded := make([]string, len(uuids))
+	for i := range uuids {
+		u, err := NewV4()
+		c.Assert(err, IsNil)
+		uuids[i] = u
+		encoded[i] = strings.ToLower(u.Base32())
+	}
+
+	Slice(uuids).Sort()
+	sort.Strings(encoded)
+	for i, u := range uuids {
+		c.Assert(encoded[i], Equals, strings.ToLower(u.Base32()))
+	}
+}
+
+func (s *base32TestSuite) TestBase32UUIDText(c *C) {
+	u := Base32UUID{NamespaceDNS}
+	c.Assert(u.String(), Equals, "3BMYW117DD278R1D00R17X8C68")
+
+	data, err := json.Marshal(map[string]Base32UUID{"id": u})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":"3BMYW117DD278R1D00R17X8C68"}`)
+
+	var decoded map[string]Base32UUID
+	err = json.Unmarshal(data, &decoded)
+	c.Assert(err, IsNil)
+	c.Assert(decoded["id"], Equals, u)
+
+	u1 := Base32UUID{}
+	err = u1.UnmarshalText([]byte(NamespaceDNS.String()))
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+}
+
+func (s *base32TestSuite) TestBase32UUIDSQL(c *C) {
+	u := Base32UUID{NamespaceURL}
+
+	val, err := u.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, NamespaceURL.Base32())
+
+	u1 := Base32UUID{}
+	err = u1.Scan(val)
+	c.Assert(err, IsNil)
+	c.Assert(u1, Equals, u)
+
+	u2 := Base32UUID{}
+	err = u2.Scan([]byte(NamespaceDNS.Base32()))
+	c.Assert(err, IsNil)
+	c.Assert(u2.UUID, Equals, NamespaceDNS)
+
+	err = u2.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+)
+
+// Length of unpadded Base64 representation of UUID.
+const base64Len = 22
+
+// URL-safe Base64 alphabet.
+const base64URLAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// Unpadded URL-safe Base64 encoding rejecting non-zero trailing bits,
+// so each UUID has exactly one representation.
+var base64Encoding = base64.RawURLEncoding.Strict()
+
+// Base64 returns 22 characters long unpadded URL-safe Base64
+// representation of UUID, e.g. "a6e4EJ2tEdGAtADAT9QwyA".
+func (u UUID) Base64() string {
+	return base64Encoding.EncodeToString(u[:])
+}
+
+// FromBase64 returns UUID parsed from 22 characters long unpadded
+// URL-safe Base64 representation. Malformed input is reported as *ParseError.
+func FromBase64(input string) (u UUID, err error) {
+	if offset, reason := u.decodeBase64([]byte(input)); reason != 0 {
+		return Nil, &ParseError{Input: input, Offset: offset, Reason: reason}
+	}
+	return u, nil
+}
+
+// decodeBase64 decodes UUID string in format "a6e4EJ2tEdGAtADAT9QwyA".
+func (u *UUID) decodeBase64(t []byte) (int, ParseErrorReason) {
+	if len(t) != base64Len {
+		return len(t), ReasonLength
+	}
+
+	// Decoder silently skips CR and LF characters,
+	// so the alphabet is checked upfront.
+	for i, c := range t {
+		if strings.IndexByte(base64URLAlphabet, c) < 0 {
+			return i, ReasonCharacter
+		}
+	}
+
+	var buf [Size + 1]byte
+	if _, err := base64Encoding.Decode(buf[:], t); err != nil {
+		// Last character holds non-zero trailing bits.
+		return len(t) - 1, ReasonFormat
+	}
+	copy(u[:], buf[:Size])
+
+	return 0, 0
+}
+
+// Base64UUID wraps UUID to be marshaled as text and JSON in
+// unpadded URL-safe Base64 representation.
+type Base64UUID struct {
+	UUID UUID
+}
+
+// String returns unpadded URL-safe Base64 representation of UUID.
+func (u Base64UUID) String() string {
+	return u.UUID.Base64()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u Base64UUID) MarshalText() ([]byte, error) {
+	return []byte(u.UUID.Base64()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *Base64UUID) UnmarshalText(text []byte) (err error) {
+	u.UUID, err = FromBase64(string(text))
+	return
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It encodes result of MarshalText as JSON string.
+func (u Base64UUID) MarshalJSON() ([]byte, error) {
+	text, err := u.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It decodes JSON string with UnmarshalText.
+func (u *Base64UUID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(s))
+}
@@ -0,0 +1,143 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding"
+	"encoding/json"
+
+	. "gopkg.in/check.v1"
+)
+
+type base64TestSuite struct{}
+
+var (
+	_ encoding.TextMarshaler   = Base64UUID{}
+	_ encoding.TextUnmarshaler = &Base64UUID{}
+	_ json.Marshaler           = Base64UUID{}
+	_ json.Unmarshaler         = &Base64UUID{}
+)
+
+var _ = Suite(&base64TestSuite{})
+
+func (s *base64TestSuite) TestBase64(c *C) {
+	c.Assert(NamespaceDNS.Base64(), Equals, "a6e4EJ2tEdGAtADAT9QwyA")
+	c.Assert(Nil.Base64(), Equals, "AAAAAAAAAAAAAAAAAAAAAA")
+	c.Assert(Max.Base64(), Equals, "_____________________w")
+
+	for _, u := range []UUID{NamespaceDNS, Nil, Max} {
+		u1, err := FromBase64(u.Base64())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *base64TestSuite) TestFromBase64Invalid(c *C) {
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonLength},
+		{"a6e4EJ2tEdGAtADAT9QwyA==", 24, ReasonLength},
+		{"a6e4EJ2tEdGAtADAT9Qwy", 21, ReasonLength},
+		{"a6e4EJ2tEdGAtADAT9Qwy+", 21, ReasonCharacter},
+		{"a6e4EJ2tEd/AtADAT9QwyA", 10, ReasonCharacter},
+		{"a6e4EJ2tEdGAtADAT9QwyB", 21, ReasonFormat},
+		{"a6e4EJ2tEdGAtADAT9Qw\r\n", 20, ReasonCharacter},
+		{"a6e4EJ2tEd\nGAtADAT9Qwy", 10, ReasonCharacter},
+	}
+
+	for _, t := range tests {
+		u, err := FromBase64(t.input)
+		c.Assert(u, Equals, Nil)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
+
+func (s *base64TestSuite) TestBase64UUID(c *C) {
+	u := Base64UUID{NamespaceDNS}
+	c.Assert(u.String(), Equals, "a6e4EJ2tEdGAtADAT9QwyA")
+
+	text, err := u.MarshalText()
+	c.Assert(err, IsNil)
+	c.Assert(string(text), Equals, "a6e4EJ2tEdGAtADAT9QwyA")
+
+	data, err := u.MarshalJSON()
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"a6e4EJ2tEdGAtADAT9QwyA"`)
+
+	data, err = json.Marshal(struct {
+		Ptr *Base64UUID `json:"ptr"`
+		ID  Base64UUID  `json:"id"`
+	}{&u, u})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"ptr":"a6e4EJ2tEdGAtADAT9QwyA","id":"a6e4EJ2tEdGAtADAT9QwyA"}`)
+
+	var decoded struct {
+		ID Base64UUID `json:"id"`
+	}
+	err = json.Unmarshal(data, &decoded)
+	c.Assert(err, IsNil)
+	c.Assert(decoded.ID, Equals, u)
+
+	err = json.Unmarshal([]byte(`{"id":42}`), &decoded)
+	c.Assert(err, NotNil)
+	err = json.Unmarshal([]byte(`{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8"}`), &decoded)
+	c.Assert(err, NotNil)
+}
+
+func (s *base64TestSuite) TestParserAllowBase64(c *C) {
+	p := Parser{AllowBase64: true, TrimSpace: true}
+
+	inputs := []string{
+		"a6e4EJ2tEdGAtADAT9QwyA",
+		" a6e4EJ2tEdGAtADAT9QwyA\n",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+	for _, input := range inputs {
+		u, err := p.FromString(input)
+		c.Assert(err, IsNil, Commentf("%q", input))
+		c.Assert(u, Equals, NamespaceDNS)
+	}
+
+	_, err := p.FromString(" a6e4EJ2tEdGAtADAT9Qwy+")
+	perr, ok := err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Offset, Equals, 22)
+	c.Assert(perr.Reason, Equals, ReasonCharacter)
+
+	_, err = Parser{AllowBase64: true}.FromString("a6e4EJ2tEdGAtADAT9Qw\r\n")
+	perr, ok = err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Offset, Equals, 20)
+	c.Assert(perr.Reason, Equals, ReasonCharacter)
+
+	_, err = Parser{}.FromString("a6e4EJ2tEdGAtADAT9QwyA")
+	c.Assert(err, NotNil)
+	_, err = Parser{AllowBase64: true, CanonicalOnly: true}.FromString("a6e4EJ2tEdGAtADAT9QwyA")
+	c.Assert(err, NotNil)
+}
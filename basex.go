@@ -0,0 +1,145 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Base58 alphabet as used by Bitcoin, without ambiguous '0', 'O', 'I' and 'l'.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// Base62 alphabet, sorted in ASCII order.
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// Predefined positional encodings. Both need 22 characters to
+// represent any 128-bit value.
+var (
+	base58 = newBaseEncoding(base58Alphabet, 22)
+	base62 = newBaseEncoding(base62Alphabet, 22)
+)
+
+// baseEncoding encodes UUID as a fixed-width big-endian number
+// in base equal to the alphabet length, padded with the zero digit.
+type baseEncoding struct {
+	alphabet string
+	width    int
+	table    [256]byte
+}
+
+func newBaseEncoding(alphabet string, width int) *baseEncoding {
+	enc := &baseEncoding{
+		alphabet: alphabet,
+		width:    width,
+	}
+	for i := range enc.table {
+		enc.table[i] = 0xff
+	}
+	for i := 0; i < len(alphabet); i++ {
+		enc.table[alphabet[i]] = byte(i)
+	}
+	return enc
+}
+
+// Base58 returns 22 characters long Base58 representation of UUID
+// using Bitcoin alphabet, e.g. "EJ34kCVxxF9jHMKD4EgrAK".
+func (u UUID) Base58() string {
+	return base58.encode(u)
+}
+
+// FromBase58 returns UUID parsed from 22 characters long Base58
+// representation. Malformed input, including values exceeding
+// 128 bits, is reported as *ParseError.
+func FromBase58(input string) (UUID, error) {
+	return base58.decode(input)
+}
+
+// Base62 returns 22 characters long Base62 representation of UUID,
+// e.g. "3H8pGALtipnCnHud4zBiky". Encoding preserves byte order, so
+// representations of UUIDs sort the same way as UUIDs themselves.
+func (u UUID) Base62() string {
+	return base62.encode(u)
+}
+
+// FromBase62 returns UUID parsed from 22 characters long Base62
+// representation. Malformed input, including values exceeding
+// 128 bits, is reported as *ParseError.
+func FromBase62(input string) (UUID, error) {
+	return base62.decode(input)
+}
+
+func (enc *baseEncoding) encode(u UUID) string {
+	buf := make([]byte, enc.width)
+	base := uint64(len(enc.alphabet))
+
+	hi, lo := u.uint128()
+	for i := enc.width - 1; i >= 0; i-- {
+		var rem uint64
+		hi, rem = hi/base, hi%base
+		lo, rem = bits.Div64(rem, lo, base)
+		buf[i] = enc.alphabet[rem]
+	}
+
+	return string(buf)
+}
+
+func (enc *baseEncoding) decode(input string) (UUID, error) {
+	if len(input) != enc.width {
+		return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+	}
+
+	base := uint64(len(enc.alphabet))
+
+	var hi, lo uint64
+	for i := 0; i < len(input); i++ {
+		v := enc.table[input[i]]
+		if v == 0xff {
+			return Nil, &ParseError{Input: input, Offset: i, Reason: ReasonCharacter}
+		}
+
+		// hi:lo = hi:lo * base + v
+		var overflow, carry, c uint64
+		overflow, hi = bits.Mul64(hi, base)
+		carry, lo = bits.Mul64(lo, base)
+		lo, c = bits.Add64(lo, uint64(v), 0)
+		hi, c = bits.Add64(hi, carry, c)
+		if overflow != 0 || c != 0 {
+			return Nil, &ParseError{Input: input, Offset: i, Reason: ReasonFormat}
+		}
+	}
+
+	return fromUint128(hi, lo), nil
+}
+
+// Returns UUID as a 128-bit big-endian number split into two halves.
+func (u UUID) uint128() (hi, lo uint64) {
+	return binary.BigEndian.Uint64(u[:8]), binary.BigEndian.Uint64(u[8:])
+}
+
+// Returns UUID from a 128-bit big-endian number split into two halves.
+func fromUint128(hi, lo uint64) UUID {
+	u := UUID{}
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return u
+}
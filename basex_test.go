@@ -0,0 +1,192 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"sort"
+	"testing"
+
+	. "gopkg.in/check.v1"
+)
+
+type basexTestSuite struct{}
+
+var _ = Suite(&basexTestSuite{})
+
+func (s *basexTestSuite) TestBase58(c *C) {
+	c.Assert(NamespaceDNS.Base58(), Equals, "EJ34kCVxxF9jHMKD4EgrAK")
+	c.Assert(Nil.Base58(), Equals, "1111111111111111111111")
+	c.Assert(Max.Base58(), Equals, "YcVfxkQb6JRzqk5kF2tNLv")
+
+	for _, u := range []UUID{NamespaceDNS, Nil, Max} {
+		u1, err := FromBase58(u.Base58())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *basexTestSuite) TestBase62(c *C) {
+	c.Assert(NamespaceDNS.Base62(), Equals, "3H8pGALtipnCnHud4zBiky")
+	c.Assert(Nil.Base62(), Equals, "0000000000000000000000")
+	c.Assert(Max.Base62(), Equals, "7n42DGM5Tflk9n8mt7Fhc7")
+
+	for _, u := range []UUID{NamespaceDNS, Nil, Max} {
+		u1, err := FromBase62(u.Base62())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *basexTestSuite) TestBaseXInvalid(c *C) {
+	tests := []struct {
+		decode func(string) (UUID, error)
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{FromBase58, "", 0, ReasonLength},
+		{FromBase58, "EJ34kCVxxF9jHMKD4EgrA", 21, ReasonLength},
+		{FromBase58, "EJ34kCVxxF9jHMKD4EgrAKK", 23, ReasonLength},
+		{FromBase58, "EJ34kCVxxF9jHMKD4Egr0K", 20, ReasonCharacter},
+		{FromBase58, "EJ34kCVxxF9jHMKl4EgrAK", 15, ReasonCharacter},
+		{FromBase58, "YcVfxkQb6JRzqk5kF2tNLw", 21, ReasonFormat},
+		{FromBase58, "zzzzzzzzzzzzzzzzzzzzzz", 21, ReasonFormat},
+		{FromBase62, "3H8pGALtipnCnHud4zBik", 21, ReasonLength},
+		{FromBase62, "3H8pGALtipnCnHud4zBik-", 21, ReasonCharacter},
+		{FromBase62, "7n42DGM5Tflk9n8mt7Fhc8", 21, ReasonFormat},
+		{FromBase62, "zzzzzzzzzzzzzzzzzzzzzz", 21, ReasonFormat},
+	}
+
+	for _, t := range tests {
+		u, err := t.decode(t.input)
+		c.Assert(u, Equals, Nil)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
+
+func (s *basexTestSuite) TestBase62Ordering(c *C) {
+	uuids := make([]UUID, 1000)
+	encoded := make([]string, len(uuids))
+	for i := range uuids {
+		u, err := NewV4()
+		c.Assert(err, IsNil)
+		uuids[i] = u
+		encoded[i] = u.Base62()
+	}
+
+	Slice(uuids).Sort()
+	sort.Strings(encoded)
+	for i, u := range uuids {
+		c.Assert(encoded[i], Equals, u.Base62())
+	}
+}
+
+func (s *basexTestSuite) BenchmarkBase58(c *C) {
+	u, err := NewV4()
+	c.Assert(err, IsNil)
+	for i := 0; i < c.N; i++ {
+		sink = u.Base58()
+	}
+}
+
+func (s *basexTestSuite) BenchmarkFromBase58(c *C) {
+	str := NamespaceDNS.Base58()
+	for i := 0; i < c.N; i++ {
+		FromBase58(str)
+	}
+}
+
+func FuzzBase58(f *testing.F) {
+	f.Add(NamespaceDNS.Bytes())
+	f.Add(Nil.Bytes())
+	f.Add(Max.Bytes())
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		u, err := FromBytes(data)
+		if err != nil {
+			return
+		}
+		s := u.Base58()
+		if len(s) != 22 {
+			t.Fatalf("Base58(%s) = %q, want 22 characters", u, s)
+		}
+		u1, err := FromBase58(s)
+		if err != nil || u1 != u {
+			t.Fatalf("FromBase58(%q) = %s, %v, want %s", s, u1, err, u)
+		}
+	})
+}
+
+func FuzzFromBase58(f *testing.F) {
+	f.Add("EJ34kCVxxF9jHMKD4EgrAK")
+	f.Add("YcVfxkQb6JRzqk5kF2tNLw")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		u, err := FromBase58(s)
+		if err != nil {
+			return
+		}
+		if s1 := u.Base58(); s1 != s {
+			t.Fatalf("Base58(FromBase58(%q)) = %q", s, s1)
+		}
+	})
+}
+
+func FuzzBase62(f *testing.F) {
+	f.Add(NamespaceDNS.Bytes())
+	f.Add(Nil.Bytes())
+	f.Add(Max.Bytes())
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		u, err := FromBytes(data)
+		if err != nil {
+			return
+		}
+		s := u.Base62()
+		if len(s) != 22 {
+			t.Fatalf("Base62(%s) = %q, want 22 characters", u, s)
+		}
+		u1, err := FromBase62(s)
+		if err != nil || u1 != u {
+			t.Fatalf("FromBase62(%q) = %s, %v, want %s", s, u1, err, u)
+		}
+	})
+}
+
+func FuzzFromBase62(f *testing.F) {
+	f.Add("3H8pGALtipnCnHud4zBiky")
+	f.Add("7n42DGM5Tflk9n8mt7Fhc8")
+
+	f.Fuzz(func(t *testing.T, s string) {
+		u, err := FromBase62(s)
+		if err != nil {
+			return
+		}
+		if s1 := u.Base62(); s1 != s {
+			t.Fatalf("Base62(FromBase62(%q)) = %q", s, s1)
+		}
+	})
+}
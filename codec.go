@@ -21,12 +21,6 @@
 
 package uuid
 
-import (
-	"bytes"
-	"encoding/hex"
-	"fmt"
-)
-
 // FromBytes returns UUID converted from raw byte slice input.
 // It will return error if the slice isn't 16 bytes long.
 func FromBytes(input []byte) (u UUID, err error) {
@@ -61,13 +55,57 @@ func FromStringOrNil(input string) UUID {
 	return uuid
 }
 
+// ParseBytes returns UUID parsed from byte slice input.
+// Input is expected in a form accepted by UnmarshalText.
+// Unlike FromString it never allocates, so on failure it returns
+// one of ErrInvalidLength, ErrInvalidFormat or ErrInvalidCharacter
+// instead of *ParseError.
+func ParseBytes(input []byte) (u UUID, err error) {
+	if _, reason := u.decodeText(input); reason != 0 {
+		return Nil, reason.err()
+	}
+	return
+}
+
 // MarshalText implements the encoding.TextMarshaler interface.
 // The encoding is the same as returned by String.
 func (u UUID) MarshalText() (text []byte, err error) {
-	text = []byte(u.String())
+	text = u.AppendString(make([]byte, 0, 36))
 	return
 }
 
+// AppendText implements the encoding.TextAppender interface.
+// The encoding is the same as returned by String.
+func (u UUID) AppendText(b []byte) ([]byte, error) {
+	return u.AppendString(b), nil
+}
+
+// AppendString appends canonical string representation of UUID
+// to b and returns the extended buffer.
+func (u UUID) AppendString(b []byte) []byte {
+	var buf [36]byte
+	encodeCanonical(buf[:], u)
+
+	return append(b, buf[:]...)
+}
+
+// encodeCanonical encodes UUID into dst in format
+// "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+// The dst must be at least 36 bytes long.
+func encodeCanonical(dst []byte, u UUID) {
+	j := 0
+	for i, b := range u {
+		switch i {
+		case 4, 6, 8, 10:
+			dst[j] = '-'
+			j++
+		}
+		dst[j] = hexDigits[b>>4]
+		dst[j+1] = hexDigits[b&0x0f]
+		j += 2
+	}
+}
+
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
 // Following formats are supported:
 //   "6ba7b810-9dad-11d1-80b4-00c04fd430c8",
@@ -91,100 +129,134 @@ func (u UUID) MarshalText() (text []byte, err error) {
 //   hexdig := '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' |
 //             'a' | 'b' | 'c' | 'd' | 'e' | 'f' |
 //             'A' | 'B' | 'C' | 'D' | 'E' | 'F'
+//
+// Malformed input is reported as *ParseError.
 func (u *UUID) UnmarshalText(text []byte) (err error) {
-	switch len(text) {
+	if offset, reason := u.decodeText(text); reason != 0 {
+		return &ParseError{Input: string(text), Offset: offset, Reason: reason}
+	}
+	return nil
+}
+
+// decodeText decodes UUID string in any supported format.
+// On failure it returns offset of the offending byte and reason
+// of the failure, otherwise it returns zero reason.
+func (u *UUID) decodeText(t []byte) (int, ParseErrorReason) {
+	switch len(t) {
 	case 32:
-		return u.decodeHashLike(text)
+		return u.decodeHashLike(t)
 	case 36:
-		return u.decodeCanonical(text)
+		return u.decodeCanonical(t)
 	case 38:
-		return u.decodeBraced(text)
+		return u.decodeBraced(t)
 	case 41:
 		fallthrough
 	case 45:
-		return u.decodeURN(text)
+		return u.decodeURN(t)
 	default:
-		return fmt.Errorf("uuid: incorrect UUID length: %s", text)
+		return len(t), ReasonLength
 	}
 }
 
 // decodeCanonical decodes UUID string in format
 // "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
-func (u *UUID) decodeCanonical(t []byte) (err error) {
-	if t[8] != '-' || t[13] != '-' || t[18] != '-' || t[23] != '-' {
-		return fmt.Errorf("uuid: incorrect UUID format %s", t)
+func (u *UUID) decodeCanonical(t []byte) (int, ParseErrorReason) {
+	for _, i := range [...]int{8, 13, 18, 23} {
+		if t[i] != '-' {
+			return i, ReasonFormat
+		}
 	}
 
 	src := t[:]
 	dst := u[:]
+	offset := 0
 
 	for i, byteGroup := range byteGroups {
 		if i > 0 {
 			src = src[1:] // skip dash
+			offset++
 		}
-		_, err = hex.Decode(dst[:byteGroup/2], src[:byteGroup])
-		if err != nil {
-			return
+		if bad := decodeHex(dst[:byteGroup/2], src[:byteGroup]); bad >= 0 {
+			return offset + bad, ReasonCharacter
 		}
 		src = src[byteGroup:]
 		dst = dst[byteGroup/2:]
+		offset += byteGroup
 	}
 
-	return
+	return 0, 0
 }
 
 // decodeHashLike decodes UUID string in format
 // "6ba7b8109dad11d180b400c04fd430c8".
-func (u *UUID) decodeHashLike(t []byte) (err error) {
-	src := t[:]
-	dst := u[:]
-
-	if _, err = hex.Decode(dst, src); err != nil {
-		return err
+func (u *UUID) decodeHashLike(t []byte) (int, ParseErrorReason) {
+	if bad := decodeHex(u[:], t); bad >= 0 {
+		return bad, ReasonCharacter
 	}
-	return
+	return 0, 0
 }
 
 // decodeBraced decodes UUID string in format
 // "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}" or in format
 // "{6ba7b8109dad11d180b400c04fd430c8}".
-func (u *UUID) decodeBraced(t []byte) (err error) {
+func (u *UUID) decodeBraced(t []byte) (int, ParseErrorReason) {
 	l := len(t)
 
-	if t[0] != '{' || t[l-1] != '}' {
-		return fmt.Errorf("uuid: incorrect UUID format %s", t)
+	if t[0] != '{' {
+		return 0, ReasonFormat
+	}
+	if t[l-1] != '}' {
+		return l - 1, ReasonFormat
 	}
 
-	return u.decodePlain(t[1 : l-1])
+	offset, reason := u.decodePlain(t[1 : l-1])
+	return offset + 1, reason
 }
 
 // decodeURN decodes UUID string in format
 // "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8" or in format
 // "urn:uuid:6ba7b8109dad11d180b400c04fd430c8".
-func (u *UUID) decodeURN(t []byte) (err error) {
-	total := len(t)
-
-	urn_uuid_prefix := t[:9]
-
-	if !bytes.Equal(urn_uuid_prefix, urnPrefix) {
-		return fmt.Errorf("uuid: incorrect UUID format: %s", t)
+func (u *UUID) decodeURN(t []byte) (int, ParseErrorReason) {
+	for i, c := range urnPrefix {
+		if t[i] != c {
+			return i, ReasonFormat
+		}
 	}
 
-	return u.decodePlain(t[9:total])
+	offset, reason := u.decodePlain(t[len(urnPrefix):])
+	return offset + len(urnPrefix), reason
 }
 
 // decodePlain decodes UUID string in canonical format
 // "6ba7b810-9dad-11d1-80b4-00c04fd430c8" or in hash-like format
 // "6ba7b8109dad11d180b400c04fd430c8".
-func (u *UUID) decodePlain(t []byte) (err error) {
+func (u *UUID) decodePlain(t []byte) (int, ParseErrorReason) {
 	switch len(t) {
 	case 32:
 		return u.decodeHashLike(t)
 	case 36:
 		return u.decodeCanonical(t)
 	default:
-		return fmt.Errorf("uuid: incorrrect UUID length: %s", t)
+		return len(t), ReasonLength
+	}
+}
+
+// decodeHex decodes hex encoded src into dst, which must be half
+// the length of src. It returns offset of the first invalid
+// character in src or -1 if src is valid.
+func decodeHex(dst, src []byte) int {
+	for i := range dst {
+		hi := hexTable[src[i*2]]
+		if hi == 0xff {
+			return i * 2
+		}
+		lo := hexTable[src[i*2+1]]
+		if lo == 0xff {
+			return i*2 + 1
+		}
+		dst[i] = hi<<4 | lo
 	}
+	return -1
 }
 
 // MarshalBinary implements the encoding.BinaryMarshaler interface.
@@ -194,10 +266,10 @@ func (u UUID) MarshalBinary() (data []byte, err error) {
 }
 
 // UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
-// It will return error if the slice isn't 16 bytes long.
+// It will return *ParseError if the slice isn't 16 bytes long.
 func (u *UUID) UnmarshalBinary(data []byte) (err error) {
 	if len(data) != Size {
-		err = fmt.Errorf("uuid: UUID must be exactly 16 bytes long, got %d bytes", len(data))
+		err = &ParseError{Input: string(data), Offset: len(data), Reason: ReasonLength, Binary: true}
 		return
 	}
 	copy(u[:], data)
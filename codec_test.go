@@ -23,6 +23,8 @@ package uuid
 
 import (
 	"bytes"
+	"errors"
+	"testing"
 
 	. "gopkg.in/check.v1"
 )
@@ -194,6 +196,14 @@ func (s *codecTestSuite) TestFromStringOrNil(c *C) {
 	c.Assert(u, Equals, Nil)
 }
 
+func (s *codecTestSuite) TestFromStringSpecialValues(c *C) {
+	c.Assert(FromStringOrNil("00000000-0000-0000-0000-000000000000"), Equals, Nil)
+	c.Assert(FromStringOrNil("ffffffff-ffff-ffff-ffff-ffffffffffff"), Equals, Max)
+	c.Assert(FromStringOrNil("FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF"), Equals, Max)
+	c.Assert(FromStringOrNil("urn:uuid:ffffffffffffffffffffffffffffffff"), Equals, Max)
+	c.Assert(FromBytesOrNil(Max.Bytes()), Equals, Max)
+}
+
 func (s *codecTestSuite) TestFromBytesOrNil(c *C) {
 	b := []byte{}
 	u := FromBytesOrNil(b)
@@ -232,6 +242,26 @@ func (s *codecTestSuite) TestUnmarshalText(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *codecTestSuite) TestTextRoundTripSpecialValues(c *C) {
+	for _, u := range []UUID{Nil, Max} {
+		text, err := u.MarshalText()
+		c.Assert(err, IsNil)
+
+		u1 := UUID{}
+		err = u1.UnmarshalText(text)
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+
+		data, err := u.MarshalBinary()
+		c.Assert(err, IsNil)
+
+		u2 := UUID{}
+		err = u2.UnmarshalBinary(data)
+		c.Assert(err, IsNil)
+		c.Assert(u2, Equals, u)
+	}
+}
+
 func (s *codecTestSuite) BenchmarkUnmarshalText(c *C) {
 	bytes := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
 	u := UUID{}
@@ -249,3 +279,91 @@ func (s *codecTestSuite) BenchmarkMarshalToString(c *C) {
 		sink = u.String()
 	}
 }
+
+func (s *codecTestSuite) TestParseBytes(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	inputs := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+	}
+	for _, input := range inputs {
+		u1, err := ParseBytes([]byte(input))
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+
+	_, err := ParseBytes([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c"))
+	c.Assert(err, Equals, ErrInvalidLength)
+	_, err = ParseBytes([]byte("6ba7b810+9dad-11d1-80b4-00c04fd430c8"))
+	c.Assert(err, Equals, ErrInvalidFormat)
+	u2, err := ParseBytes([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430cx"))
+	c.Assert(err, Equals, ErrInvalidCharacter)
+	c.Assert(u2, Equals, Nil)
+}
+
+func (s *codecTestSuite) TestAppendText(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	b, err := u.AppendText([]byte("id="))
+	c.Assert(err, IsNil)
+	c.Assert(string(b), Equals, "id=6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	b = Max.AppendString(nil)
+	c.Assert(string(b), Equals, "ffffffff-ffff-ffff-ffff-ffffffffffff")
+}
+
+func (s *codecTestSuite) TestZeroAllocs(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	valid := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	invalid := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430cx")
+	buf := make([]byte, 0, 64)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = u.AppendString(buf[:0])
+	})
+	c.Assert(allocs, Equals, 0.0)
+
+	allocs = testing.AllocsPerRun(100, func() {
+		ParseBytes(valid)
+	})
+	c.Assert(allocs, Equals, 0.0)
+
+	allocs = testing.AllocsPerRun(100, func() {
+		ParseBytes(invalid)
+	})
+	c.Assert(allocs, Equals, 0.0)
+
+	allocs = testing.AllocsPerRun(100, func() {
+		u.UnmarshalText(valid)
+	})
+	c.Assert(allocs, Equals, 0.0)
+}
+
+func (s *codecTestSuite) BenchmarkParseBytes(c *C) {
+	bytes := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	for i := 0; i < c.N; i++ {
+		ParseBytes(bytes)
+	}
+}
+
+func (s *codecTestSuite) BenchmarkParseBytesInvalid(c *C) {
+	bytes := []byte("6ba7b810-9dad-11d1-80b4-00c04fd430cx")
+	for i := 0; i < c.N; i++ {
+		if _, err := ParseBytes(bytes); !errors.Is(err, ErrInvalidCharacter) {
+			c.Fatal(err)
+		}
+	}
+}
+
+func (s *codecTestSuite) BenchmarkAppendString(c *C) {
+	u, err := NewV4()
+	c.Assert(err, IsNil)
+	buf := make([]byte, 0, 36)
+	for i := 0; i < c.N; i++ {
+		buf = u.AppendString(buf[:0])
+	}
+}
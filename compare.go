@@ -0,0 +1,119 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+)
+
+// Compare returns an integer comparing u and v lexicographically
+// by their bytes. The result will be 0 if u == v, -1 if u < v
+// and +1 if u > v.
+func (u UUID) Compare(v UUID) int {
+	return bytes.Compare(u[:], v[:])
+}
+
+// Less returns true if u sorts before v, otherwise returns false.
+func (u UUID) Less(v UUID) bool {
+	return u.Compare(v) < 0
+}
+
+// CompareTime returns an integer comparing u and v by time of their
+// generation. Version 1 UUIDs are ordered by embedded timestamp and
+// sort before any other UUID, while all other UUIDs are ordered by
+// their bytes. Ties between timestamps are broken by bytes, so the
+// result is 0 only if u == v.
+func CompareTime(u, v UUID) int {
+	ut, uok := u.v1Timestamp()
+	vt, vok := v.v1Timestamp()
+
+	switch {
+	case uok && !vok:
+		return -1
+	case !uok && vok:
+		return 1
+	case uok && vok && ut < vt:
+		return -1
+	case uok && vok && ut > vt:
+		return 1
+	}
+
+	return u.Compare(v)
+}
+
+// Returns 60-bit timestamp embedded in version 1 UUID.
+func (u UUID) v1Timestamp() (uint64, bool) {
+	if u.Version() != V1 || u.Variant() != VariantRFC4122 {
+		return 0, false
+	}
+
+	low := uint64(binary.BigEndian.Uint32(u[0:4]))
+	mid := uint64(binary.BigEndian.Uint16(u[4:6]))
+	high := uint64(binary.BigEndian.Uint16(u[6:8]) & 0x0fff)
+
+	return high<<48 | mid<<32 | low, true
+}
+
+// Slice attaches the methods of sort.Interface to []UUID,
+// sorting in increasing order as defined by Compare.
+type Slice []UUID
+
+func (s Slice) Len() int           { return len(s) }
+func (s Slice) Less(i, j int) bool { return s[i].Less(s[j]) }
+func (s Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort is a convenience method.
+func (s Slice) Sort() { sort.Sort(s) }
+
+// Search returns the index to insert u into sorted slice s
+// as defined by sort.Search. The slice must be sorted in
+// increasing order as defined by Compare.
+func (s Slice) Search(u UUID) int {
+	return sort.Search(len(s), func(i int) bool { return s[i].Compare(u) >= 0 })
+}
+
+// Contains returns true if sorted slice s contains u,
+// otherwise returns false.
+func (s Slice) Contains(u UUID) bool {
+	i := s.Search(u)
+	return i < len(s) && s[i] == u
+}
+
+// TimeSlice attaches the methods of sort.Interface to []UUID,
+// sorting in increasing order as defined by CompareTime.
+type TimeSlice []UUID
+
+func (s TimeSlice) Len() int           { return len(s) }
+func (s TimeSlice) Less(i, j int) bool { return CompareTime(s[i], s[j]) < 0 }
+func (s TimeSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort is a convenience method.
+func (s TimeSlice) Sort() { sort.Sort(s) }
+
+// Search returns the index to insert u into sorted slice s
+// as defined by sort.Search. The slice must be sorted in
+// increasing order as defined by CompareTime.
+func (s TimeSlice) Search(u UUID) int {
+	return sort.Search(len(s), func(i int) bool { return CompareTime(s[i], u) >= 0 })
+}
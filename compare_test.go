@@ -0,0 +1,103 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type compareTestSuite struct{}
+
+var _ = Suite(&compareTestSuite{})
+
+func (s *compareTestSuite) TestCompare(c *C) {
+	u1 := UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	u2 := UUID{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+	c.Assert(u1.Compare(u1), Equals, 0)
+	c.Assert(u1.Compare(u2), Equals, -1)
+	c.Assert(u2.Compare(u1), Equals, 1)
+	c.Assert(Nil.Compare(Max), Equals, -1)
+
+	c.Assert(u1.Less(u2), Equals, true)
+	c.Assert(u2.Less(u1), Equals, false)
+	c.Assert(u1.Less(u1), Equals, false)
+}
+
+func (s *compareTestSuite) TestSlice(c *C) {
+	a := Slice{Max, NamespaceX500, NamespaceDNS, Nil, NamespaceURL}
+	a.Sort()
+	c.Assert([]UUID(a), DeepEquals, []UUID{Nil, NamespaceDNS, NamespaceURL, NamespaceX500, Max})
+
+	c.Assert(a.Search(Nil), Equals, 0)
+	c.Assert(a.Search(NamespaceURL), Equals, 2)
+	c.Assert(a.Search(NamespaceOID), Equals, 3)
+	c.Assert(a.Contains(NamespaceX500), Equals, true)
+	c.Assert(a.Contains(NamespaceOID), Equals, false)
+	c.Assert(Slice{}.Contains(Nil), Equals, false)
+}
+
+func (s *compareTestSuite) TestCompareTime(c *C) {
+	// Earlier timestamp with greater time_low, so byte order disagrees with time order.
+	early := UUID{0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x10, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	late := UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x10, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+	tie := UUID{0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x10, 0x00, 0x80, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}
+
+	c.Assert(early.Compare(late), Equals, 1)
+	c.Assert(CompareTime(early, late), Equals, -1)
+	c.Assert(CompareTime(late, early), Equals, 1)
+	c.Assert(CompareTime(late, late), Equals, 0)
+	c.Assert(CompareTime(late, tie), Equals, -1)
+
+	v4, err := NewV4()
+	c.Assert(err, IsNil)
+	c.Assert(CompareTime(early, v4), Equals, -1)
+	c.Assert(CompareTime(v4, late), Equals, 1)
+	c.Assert(CompareTime(Nil, Max), Equals, -1)
+}
+
+func (s *compareTestSuite) TestTimeSlice(c *C) {
+	var ticks int64
+	g := newRFC4122Generator()
+	g.epochFunc = func() time.Time {
+		ticks += 1 << 20
+		return time.Unix(0, ticks)
+	}
+
+	ordered := make([]UUID, 8)
+	for i := range ordered {
+		u, err := g.NewV1()
+		c.Assert(err, IsNil)
+		ordered[i] = u
+	}
+	ordered = append(ordered, Nil, Max)
+
+	a := TimeSlice{ordered[9], ordered[3], ordered[7], ordered[0], ordered[8], ordered[5], ordered[1], ordered[6], ordered[2], ordered[4]}
+	a.Sort()
+	c.Assert([]UUID(a), DeepEquals, ordered)
+
+	for i, u := range ordered {
+		c.Assert(a.Search(u), Equals, i)
+	}
+}
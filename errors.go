@@ -0,0 +1,127 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Sentinel errors reported by parsing functions. Errors returned by
+// UnmarshalText, UnmarshalBinary and Scan can be matched against them
+// with errors.Is.
+var (
+	ErrInvalidLength    = errors.New("uuid: incorrect UUID length")
+	ErrInvalidFormat    = errors.New("uuid: incorrect UUID format")
+	ErrInvalidCharacter = errors.New("uuid: invalid UUID character")
+	ErrChecksum         = errors.New("uuid: UUID checksum mismatch")
+	ErrUnsupportedType  = errors.New("uuid: unsupported type")
+)
+
+// ParseErrorReason describes why input could not be parsed.
+type ParseErrorReason int
+
+// Parse error reasons.
+const (
+	ReasonLength ParseErrorReason = iota + 1
+	ReasonFormat
+	ReasonCharacter
+	ReasonChecksum
+	ReasonWord
+)
+
+// String returns name of parse error reason.
+func (r ParseErrorReason) String() string {
+	switch r {
+	case ReasonLength:
+		return "length"
+	case ReasonFormat:
+		return "format"
+	case ReasonCharacter:
+		return "character"
+	case ReasonChecksum:
+		return "checksum"
+	case ReasonWord:
+		return "word"
+	default:
+		return fmt.Sprintf("ParseErrorReason(%d)", int(r))
+	}
+}
+
+// Returns sentinel error corresponding to parse error reason.
+func (r ParseErrorReason) err() error {
+	switch r {
+	case ReasonLength:
+		return ErrInvalidLength
+	case ReasonFormat:
+		return ErrInvalidFormat
+	case ReasonCharacter, ReasonWord:
+		return ErrInvalidCharacter
+	case ReasonChecksum:
+		return ErrChecksum
+	default:
+		return nil
+	}
+}
+
+// ParseError is returned when input cannot be parsed as UUID.
+type ParseError struct {
+	Input  string           // input being parsed
+	Offset int              // offset of the offending byte, or input length for length errors
+	Reason ParseErrorReason // reason of the failure
+	Binary bool             // whether Input holds raw bytes rather than text
+}
+
+// Error implements the error interface.
+func (e *ParseError) Error() string {
+	if e.Binary {
+		return fmt.Sprintf("uuid: UUID must be exactly 16 bytes long, got %d bytes", len(e.Input))
+	}
+
+	switch e.Reason {
+	case ReasonLength:
+		return fmt.Sprintf("uuid: incorrect UUID length %d in string %q", len(e.Input), e.Input)
+	case ReasonCharacter:
+		if 0 <= e.Offset && e.Offset < len(e.Input) {
+			return fmt.Sprintf("uuid: invalid character %q at offset %d in string %q", e.Input[e.Offset], e.Offset, e.Input)
+		}
+	case ReasonWord:
+		if 0 <= e.Offset && e.Offset < len(e.Input) {
+			word := e.Input[e.Offset:]
+			if i := strings.IndexAny(word, "- \t\n\v\f\r"); i >= 0 {
+				word = word[:i]
+			}
+			return fmt.Sprintf("uuid: unknown word %q at offset %d in string %q", word, e.Offset, e.Input)
+		}
+	case ReasonChecksum:
+		return fmt.Sprintf("uuid: checksum mismatch in string %q", e.Input)
+	}
+
+	return fmt.Sprintf("uuid: incorrect UUID format at offset %d in string %q", e.Offset, e.Input)
+}
+
+// Unwrap returns sentinel error corresponding to the reason,
+// so ParseError can be matched with errors.Is.
+func (e *ParseError) Unwrap() error {
+	return e.Reason.err()
+}
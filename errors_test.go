@@ -0,0 +1,135 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+type errorsTestSuite struct{}
+
+var _ = Suite(&errorsTestSuite{})
+
+func (s *errorsTestSuite) TestParseErrorText(c *C) {
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonLength},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c", 35, ReasonLength},
+		{"6ba7b810+9dad-11d1-80b4-00c04fd430c8", 8, ReasonFormat},
+		{"6ba7b810-9dad-11d1-80b4+00c04fd430c8", 23, ReasonFormat},
+		{"zba7b810-9dad-11d1-80b4-00c04fd430c8", 0, ReasonCharacter},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430cx", 35, ReasonCharacter},
+		{"6ba7b810-9dad-11g1-80b4-00c04fd430c8", 16, ReasonCharacter},
+		{"6ba7b8109dad11d180b400c04fd430cz", 31, ReasonCharacter},
+		{"(6ba7b810-9dad-11d1-80b4-00c04fd430c8}", 0, ReasonFormat},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8>", 37, ReasonFormat},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd4_0c8}", 33, ReasonCharacter},
+		{"uuid:urn:6ba7b810-9dad-11d1-80b4-00c04fd430c8", 1, ReasonFormat},
+		{"urn:uuid+6ba7b810-9dad-11d1-80b4-00c04fd430c8", 8, ReasonFormat},
+		{"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c_", 44, ReasonCharacter},
+		{"urn:uuid:6ba7b8109dad11d180b400c04fd430c_", 40, ReasonCharacter},
+	}
+
+	for _, t := range tests {
+		_, err := FromString(t.input)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Input, Equals, t.input)
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+		c.Assert(perr.Binary, Equals, false)
+	}
+}
+
+func (s *errorsTestSuite) TestParseErrorOffsetOutOfRange(c *C) {
+	for _, reason := range []ParseErrorReason{ReasonCharacter, ReasonWord} {
+		for _, offset := range []int{-1, 2, 5} {
+			err := &ParseError{Input: "ab", Offset: offset, Reason: reason}
+			c.Assert(err.Error(), Equals, fmt.Sprintf("uuid: incorrect UUID format at offset %d in string \"ab\"", offset))
+		}
+	}
+}
+
+func (s *errorsTestSuite) TestParseErrorIs(c *C) {
+	_, err := FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c")
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+	c.Assert(errors.Is(err, ErrInvalidFormat), Equals, false)
+	c.Assert(err.Error(), Equals, `uuid: incorrect UUID length 35 in string "6ba7b810-9dad-11d1-80b4-00c04fd430c"`)
+
+	_, err = FromString("6ba7b810+9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(errors.Is(err, ErrInvalidFormat), Equals, true)
+	c.Assert(err.Error(), Equals, `uuid: incorrect UUID format at offset 8 in string "6ba7b810+9dad-11d1-80b4-00c04fd430c8"`)
+
+	_, err = FromString("6ba7b810-9dad-11d1-80b4-00c04fd430cx")
+	c.Assert(errors.Is(err, ErrInvalidCharacter), Equals, true)
+	c.Assert(err.Error(), Equals, `uuid: invalid character 'x' at offset 35 in string "6ba7b810-9dad-11d1-80b4-00c04fd430cx"`)
+
+	var perr *ParseError
+	c.Assert(errors.As(err, &perr), Equals, true)
+	c.Assert(perr.Offset, Equals, 35)
+}
+
+func (s *errorsTestSuite) TestParseErrorBinary(c *C) {
+	_, err := FromBytes([]byte{0x01, 0x02})
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+	c.Assert(err.Error(), Equals, "uuid: UUID must be exactly 16 bytes long, got 2 bytes")
+
+	perr, ok := err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Binary, Equals, true)
+	c.Assert(perr.Offset, Equals, 2)
+}
+
+func (s *errorsTestSuite) TestParseErrorScan(c *C) {
+	u := UUID{}
+
+	err := u.Scan("6ba7b810-9dad-11d1-80b4-00c04fd430cx")
+	c.Assert(errors.Is(err, ErrInvalidCharacter), Equals, true)
+
+	err = u.Scan([]byte("{6ba7b810-9dad-11d1-80b4-00c04fd430c8>"))
+	c.Assert(errors.Is(err, ErrInvalidFormat), Equals, true)
+
+	err = u.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+	c.Assert(err.Error(), Equals, "uuid: unsupported type: cannot convert int to UUID")
+
+	n := NullUUID{}
+	err = n.Scan([]byte{0x01})
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+}
+
+func (s *errorsTestSuite) TestParseErrorReasonString(c *C) {
+	c.Assert(ReasonLength.String(), Equals, "length")
+	c.Assert(ReasonFormat.String(), Equals, "format")
+	c.Assert(ReasonCharacter.String(), Equals, "character")
+	c.Assert(ReasonChecksum.String(), Equals, "checksum")
+	c.Assert(ReasonWord.String(), Equals, "word")
+	c.Assert(ParseErrorReason(0).String(), Equals, "ParseErrorReason(0)")
+	c.Assert((&ParseError{Reason: ParseErrorReason(0)}).Unwrap(), IsNil)
+}
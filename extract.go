@@ -0,0 +1,200 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// Longest UUID text representation, "urn:uuid:" followed by canonical form.
+const maxTextLen = 45
+
+// Match is UUID found in text.
+type Match struct {
+	UUID   UUID
+	Offset int // offset of the UUID text representation
+	Length int // length of the UUID text representation
+}
+
+// Extract returns all UUIDs found in text in any format accepted
+// by UnmarshalText. UUIDs directly preceded or followed by a hex
+// digit are ignored, so no UUIDs are reported inside longer hex runs.
+func Extract(text []byte) []Match {
+	var matches []Match
+
+	for i := 0; i < len(text); i++ {
+		if i > 0 && isHexChar(text[i-1]) {
+			continue
+		}
+		if u, n := matchAt(text, i); n > 0 {
+			matches = append(matches, Match{UUID: u, Offset: i, Length: n})
+			i += n - 1
+		}
+	}
+
+	return matches
+}
+
+// ExtractString returns all UUIDs found in string s,
+// as defined by Extract.
+func ExtractString(s string) []Match {
+	return Extract([]byte(s))
+}
+
+// Scanner reads UUIDs from io.Reader, finding them the same way
+// as Extract does. It is built on top of bufio.Scanner:
+//
+//	scanner := uuid.NewScanner(r)
+//	for scanner.Scan() {
+//		fmt.Println(scanner.Offset(), scanner.UUID())
+//	}
+//	if err := scanner.Err(); err != nil {
+//		// handle error
+//	}
+type Scanner struct {
+	scanner *bufio.Scanner
+	prevHex bool  // whether the last consumed byte is a hex digit
+	offset  int64 // stream offset of the first unconsumed byte
+	match   UUID
+	start   int64
+}
+
+// NewScanner returns Scanner reading from r.
+func NewScanner(r io.Reader) *Scanner {
+	s := &Scanner{scanner: bufio.NewScanner(r)}
+	s.scanner.Split(s.Split)
+
+	return s
+}
+
+// Scan advances the Scanner to the next UUID, which will then be
+// available through UUID and Offset methods. It returns false when
+// the scan stops, either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	return s.scanner.Scan()
+}
+
+// UUID returns the most recent UUID found by a call to Scan.
+func (s *Scanner) UUID() UUID {
+	return s.match
+}
+
+// Offset returns offset in the stream of the most recent UUID
+// found by a call to Scan.
+func (s *Scanner) Offset() int64 {
+	return s.start
+}
+
+// Text returns text representation of the most recent UUID
+// found by a call to Scan, as it appeared in the input.
+func (s *Scanner) Text() string {
+	return s.scanner.Text()
+}
+
+// Err returns the first non-EOF error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
+
+// Split is a bufio.SplitFunc returning UUID text representations
+// as tokens. It keeps track of consumed input, so it must be used
+// for a single stream only.
+func (s *Scanner) Split(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	for i := 0; i < len(data); i++ {
+		// Not enough data to tell whether UUID ends before a hex digit.
+		if !atEOF && len(data)-i <= maxTextLen {
+			s.consume(data[:i])
+			return i, nil, nil
+		}
+
+		if i == 0 && s.prevHex || i > 0 && isHexChar(data[i-1]) {
+			continue
+		}
+		if u, n := matchAt(data, i); n > 0 {
+			s.match = u
+			s.start = s.offset + int64(i)
+			s.consume(data[:i+n])
+			return i + n, data[i : i+n], nil
+		}
+	}
+
+	s.consume(data)
+	return len(data), nil, nil
+}
+
+// Records data as consumed.
+func (s *Scanner) consume(data []byte) {
+	if len(data) > 0 {
+		s.prevHex = isHexChar(data[len(data)-1])
+		s.offset += int64(len(data))
+	}
+}
+
+// matchAt returns UUID which text representation starts at t[i]
+// and its length, or zero length if there is none. End of t is
+// treated as the end of text.
+func matchAt(t []byte, i int) (UUID, int) {
+	rest := t[i:]
+
+	switch {
+	case bytes.HasPrefix(rest, urnPrefix):
+		for _, l := range [...]int{36, 32} {
+			if u, ok := matchPlain(rest[len(urnPrefix):], l); ok {
+				return u, len(urnPrefix) + l
+			}
+		}
+	case rest[0] == '{':
+		if len(rest) >= 38 && rest[37] == '}' {
+			u := UUID{}
+			if _, reason := u.decodeCanonical(rest[1:37]); reason == 0 {
+				return u, 38
+			}
+		}
+	default:
+		for _, l := range [...]int{36, 32} {
+			if u, ok := matchPlain(rest, l); ok {
+				return u, l
+			}
+		}
+	}
+
+	return Nil, 0
+}
+
+// matchPlain decodes UUID from the first l bytes of t, if they hold
+// canonical or hash-like representation not followed by a hex digit.
+func matchPlain(t []byte, l int) (u UUID, ok bool) {
+	if len(t) < l || len(t) > l && isHexChar(t[l]) {
+		return Nil, false
+	}
+	if _, reason := u.decodePlain(t[:l]); reason != 0 {
+		return Nil, false
+	}
+	return u, true
+}
+
+// Returns true if c is a hex digit.
+func isHexChar(c byte) bool {
+	return hexTable[c] != 0xff
+}
@@ -0,0 +1,115 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"strings"
+	"testing/iotest"
+
+	. "gopkg.in/check.v1"
+)
+
+type extractTestSuite struct{}
+
+var _ = Suite(&extractTestSuite{})
+
+const extractText = `2018-01-02 request 6ba7b810-9dad-11d1-80b4-00c04fd430c8 failed
+retrying {6BA7B811-9DAD-11D1-80B4-00C04FD430C8} via urn:uuid:6ba7b8129dad11d180b400c04fd430c8,
+sha=6ba7b8149dad11d180b400c04fd430c8ff 6ba7b8149dad11d180b400c04fd430c8-
+ignored a6ba7b810-9dad-11d1-80b4-00c04fd430c8 and 6ba7b810-9dad-11d1-80b4-00c04fd430c8f
+last:6ba7b814-9dad-11d1-80b4-00c04fd430c8`
+
+func (s *extractTestSuite) TestExtract(c *C) {
+	matches := ExtractString(extractText)
+
+	expected := []struct {
+		u    UUID
+		text string
+	}{
+		{NamespaceDNS, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{NamespaceURL, "{6BA7B811-9DAD-11D1-80B4-00C04FD430C8}"},
+		{NamespaceOID, "urn:uuid:6ba7b8129dad11d180b400c04fd430c8"},
+		{NamespaceX500, "6ba7b8149dad11d180b400c04fd430c8"},
+		{NamespaceX500, "6ba7b814-9dad-11d1-80b4-00c04fd430c8"},
+	}
+
+	c.Assert(matches, HasLen, len(expected))
+	for i, m := range matches {
+		c.Assert(m.UUID, Equals, expected[i].u)
+		c.Assert(extractText[m.Offset:m.Offset+m.Length], Equals, expected[i].text)
+	}
+}
+
+func (s *extractTestSuite) TestExtractEmpty(c *C) {
+	c.Assert(Extract(nil), HasLen, 0)
+	c.Assert(ExtractString("no identifiers here"), HasLen, 0)
+	c.Assert(ExtractString("6ba7b8109dad11d180b400c04fd430c86ba7b8109dad11d180b400c04fd430c8"), HasLen, 0)
+	c.Assert(ExtractString("{6ba7b810-9dad-11d1-80b4-00c04fd430c8"), HasLen, 1)
+}
+
+func (s *extractTestSuite) TestScanner(c *C) {
+	expected := ExtractString(extractText)
+
+	readers := []*Scanner{
+		NewScanner(strings.NewReader(extractText)),
+		NewScanner(iotest.OneByteReader(strings.NewReader(extractText))),
+		NewScanner(iotest.HalfReader(strings.NewReader(extractText))),
+	}
+
+	for _, scanner := range readers {
+		i := 0
+		for scanner.Scan() {
+			c.Assert(i < len(expected), Equals, true)
+			c.Assert(scanner.UUID(), Equals, expected[i].UUID)
+			c.Assert(scanner.Offset(), Equals, int64(expected[i].Offset))
+			c.Assert(scanner.Text(), Equals, extractText[expected[i].Offset:expected[i].Offset+expected[i].Length])
+			i++
+		}
+		c.Assert(scanner.Err(), IsNil)
+		c.Assert(i, Equals, len(expected))
+	}
+}
+
+func (s *extractTestSuite) TestScannerLongInput(c *C) {
+	// Hex run crossing internal buffer boundaries must not produce matches.
+	text := strings.Repeat("0", 100000) + " " + NamespaceDNS.String() + strings.Repeat(" ", 100000) + NamespaceURL.String()
+
+	scanner := NewScanner(strings.NewReader(text))
+
+	c.Assert(scanner.Scan(), Equals, true)
+	c.Assert(scanner.UUID(), Equals, NamespaceDNS)
+	c.Assert(scanner.Offset(), Equals, int64(100001))
+
+	c.Assert(scanner.Scan(), Equals, true)
+	c.Assert(scanner.UUID(), Equals, NamespaceURL)
+	c.Assert(scanner.Offset(), Equals, int64(200037))
+
+	c.Assert(scanner.Scan(), Equals, false)
+	c.Assert(scanner.Err(), IsNil)
+}
+
+func (s *extractTestSuite) BenchmarkExtract(c *C) {
+	text := []byte(extractText)
+	for i := 0; i < c.N; i++ {
+		Extract(text)
+	}
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Format is UUID text representation.
+type Format int
+
+// UUID text representations.
+const (
+	FormatCanonical      Format = iota // "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	FormatHashLike                     // "6ba7b8109dad11d180b400c04fd430c8"
+	FormatBraced                       // "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"
+	FormatURN                          // "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+	FormatUpperCanonical               // "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"
+	FormatUpperHashLike                // "6BA7B8109DAD11D180B400C04FD430C8"
+	FormatUpperBraced                  // "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"
+)
+
+// String returns name of text representation.
+func (f Format) String() string {
+	switch f {
+	case FormatCanonical:
+		return "canonical"
+	case FormatHashLike:
+		return "hash-like"
+	case FormatBraced:
+		return "braced"
+	case FormatURN:
+		return "urn"
+	case FormatUpperCanonical:
+		return "upper-canonical"
+	case FormatUpperHashLike:
+		return "upper-hash-like"
+	case FormatUpperBraced:
+		return "upper-braced"
+	default:
+		return fmt.Sprintf("Format(%d)", int(f))
+	}
+}
+
+// Returns true if f is one of predefined formats.
+func (f Format) known() bool {
+	return f >= FormatCanonical && f <= FormatUpperBraced
+}
+
+// AppendFormat appends text representation of UUID in format f
+// to b and returns the extended buffer. Unknown formats are
+// rendered as canonical.
+func (u UUID) AppendFormat(b []byte, f Format) []byte {
+	var buf [36]byte
+	encodeCanonical(buf[:], u)
+
+	text := buf[:]
+	switch f {
+	case FormatHashLike, FormatUpperHashLike:
+		copy(text[8:], text[9:13])
+		copy(text[12:], text[14:18])
+		copy(text[16:], text[19:23])
+		copy(text[20:], text[24:])
+		text = text[:32]
+	}
+
+	switch f {
+	case FormatUpperCanonical, FormatUpperHashLike, FormatUpperBraced:
+		for i, c := range text {
+			if 'a' <= c && c <= 'f' {
+				text[i] = c - 'a' + 'A'
+			}
+		}
+	}
+
+	switch f {
+	case FormatBraced, FormatUpperBraced:
+		b = append(b, '{')
+		b = append(b, text...)
+		return append(b, '}')
+	case FormatURN:
+		b = append(b, urnPrefix...)
+		return append(b, text...)
+	default:
+		return append(b, text...)
+	}
+}
+
+// StringFormat returns text representation of UUID in format f.
+func (u UUID) StringFormat(f Format) string {
+	return string(u.AppendFormat(make([]byte, 0, 45), f))
+}
+
+// HashLike returns hash-like string representation of UUID:
+// xxxxxxxxxxxxxxxxxxxxxxxxxxxxxxxx.
+func (u UUID) HashLike() string {
+	return u.StringFormat(FormatHashLike)
+}
+
+// Braced returns braced string representation of UUID:
+// {xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx}.
+func (u UUID) Braced() string {
+	return u.StringFormat(FormatBraced)
+}
+
+// URN returns URN string representation of UUID:
+// urn:uuid:xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
+func (u UUID) URN() string {
+	return u.StringFormat(FormatURN)
+}
+
+// FormattedUUID wraps UUID to be marshaled as text and stored
+// in database in chosen format. Any format accepted by
+// UnmarshalText is accepted on input.
+type FormattedUUID struct {
+	UUID   UUID
+	Format Format
+}
+
+// String returns text representation of UUID in chosen format.
+func (u FormattedUUID) String() string {
+	return u.UUID.StringFormat(u.Format)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// It returns error for unknown format.
+func (u FormattedUUID) MarshalText() ([]byte, error) {
+	if !u.Format.known() {
+		return nil, fmt.Errorf("uuid: unknown format %s", u.Format)
+	}
+	return u.UUID.AppendFormat(nil, u.Format), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Format is left unchanged.
+func (u *FormattedUUID) UnmarshalText(text []byte) error {
+	return u.UUID.UnmarshalText(text)
+}
+
+// Value implements the driver.Valuer interface.
+// It returns error for unknown format.
+func (u FormattedUUID) Value() (driver.Value, error) {
+	if !u.Format.known() {
+		return nil, fmt.Errorf("uuid: unknown format %s", u.Format)
+	}
+	return u.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Format is left unchanged.
+func (u *FormattedUUID) Scan(src interface{}) error {
+	return u.UUID.Scan(src)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/json"
+
+	. "gopkg.in/check.v1"
+)
+
+type formatTestSuite struct{}
+
+var _ = Suite(&formatTestSuite{})
+
+func (s *formatTestSuite) TestStringFormat(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	tests := []struct {
+		format   Format
+		expected string
+	}{
+		{FormatCanonical, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{FormatHashLike, "6ba7b8109dad11d180b400c04fd430c8"},
+		{FormatBraced, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{FormatURN, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{FormatUpperCanonical, "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{FormatUpperHashLike, "6BA7B8109DAD11D180B400C04FD430C8"},
+		{FormatUpperBraced, "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"},
+		{Format(42), "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+	}
+
+	for _, t := range tests {
+		c.Assert(u.StringFormat(t.format), Equals, t.expected)
+		c.Assert(string(u.AppendFormat([]byte("id:"), t.format)), Equals, "id:"+t.expected)
+
+		u1, err := FromString(t.expected)
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+
+	c.Assert(u.HashLike(), Equals, "6ba7b8109dad11d180b400c04fd430c8")
+	c.Assert(u.Braced(), Equals, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}")
+	c.Assert(u.URN(), Equals, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+}
+
+func (s *formatTestSuite) TestFormatString(c *C) {
+	c.Assert(FormatCanonical.String(), Equals, "canonical")
+	c.Assert(FormatHashLike.String(), Equals, "hash-like")
+	c.Assert(FormatBraced.String(), Equals, "braced")
+	c.Assert(FormatURN.String(), Equals, "urn")
+	c.Assert(FormatUpperCanonical.String(), Equals, "upper-canonical")
+	c.Assert(FormatUpperHashLike.String(), Equals, "upper-hash-like")
+	c.Assert(FormatUpperBraced.String(), Equals, "upper-braced")
+	c.Assert(Format(42).String(), Equals, "Format(42)")
+}
+
+func (s *formatTestSuite) TestFormattedUUIDText(c *C) {
+	u := FormattedUUID{UUID: NamespaceDNS, Format: FormatUpperBraced}
+	c.Assert(u.String(), Equals, "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}")
+
+	text, err := u.MarshalText()
+	c.Assert(err, IsNil)
+	c.Assert(string(text), Equals, "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}")
+
+	data, err := json.Marshal(struct{ ID FormattedUUID }{u})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"ID":"{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"}`)
+
+	u1 := FormattedUUID{Format: FormatURN}
+	err = u1.UnmarshalText([]byte("6ba7b811-9dad-11d1-80b4-00c04fd430c8"))
+	c.Assert(err, IsNil)
+	c.Assert(u1.UUID, Equals, NamespaceURL)
+	c.Assert(u1.Format, Equals, FormatURN)
+
+	err = u1.UnmarshalText([]byte("invalid"))
+	c.Assert(err, NotNil)
+
+	_, err = FormattedUUID{UUID: NamespaceDNS, Format: 42}.MarshalText()
+	c.Assert(err, ErrorMatches, `uuid: unknown format Format\(42\)`)
+
+	_, err = json.Marshal(FormattedUUID{UUID: NamespaceDNS, Format: -1})
+	c.Assert(err, NotNil)
+}
+
+func (s *formatTestSuite) TestFormattedUUIDSQL(c *C) {
+	u := FormattedUUID{UUID: NamespaceDNS, Format: FormatURN}
+
+	val, err := u.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	u1 := FormattedUUID{Format: FormatHashLike}
+	err = u1.Scan(val)
+	c.Assert(err, IsNil)
+	c.Assert(u1.UUID, Equals, NamespaceDNS)
+	c.Assert(u1.Format, Equals, FormatHashLike)
+
+	err = u1.Scan(NamespaceURL.Bytes())
+	c.Assert(err, IsNil)
+	c.Assert(u1.UUID, Equals, NamespaceURL)
+
+	err = u1.Scan(42)
+	c.Assert(err, NotNil)
+
+	_, err = FormattedUUID{UUID: NamespaceDNS, Format: 42}.Value()
+	c.Assert(err, ErrorMatches, `uuid: unknown format Format\(42\)`)
+}
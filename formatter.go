@@ -0,0 +1,141 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Format implements the fmt.Formatter interface.
+// Following verbs are supported:
+//
+//	%s, %v  canonical "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+//	%S      upper-case canonical "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"
+//	%#s     braced "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", %#S in upper case
+//	%x      hash-like "6ba7b8109dad11d180b400c04fd430c8"
+//	%X      upper-case hash-like "6BA7B8109DAD11D180B400C04FD430C8"
+//	%q      double-quoted canonical, %#q back-quoted canonical
+//	%+v     canonical followed by version, variant and timestamp of V1 UUIDs
+//	%#v     Go syntax representation
+//
+// Width and '-' flag are honoured for all the verbs except %#v.
+func (u UUID) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		switch {
+		case f.Flag('#'):
+			io.WriteString(f, u.goString())
+		case f.Flag('+'):
+			pad(f, u.details())
+		default:
+			pad(f, u.String())
+		}
+	case 's':
+		if f.Flag('#') {
+			pad(f, u.StringFormat(FormatBraced))
+			return
+		}
+		pad(f, u.String())
+	case 'S':
+		if f.Flag('#') {
+			pad(f, u.StringFormat(FormatUpperBraced))
+			return
+		}
+		pad(f, u.StringFormat(FormatUpperCanonical))
+	case 'x':
+		pad(f, u.StringFormat(FormatHashLike))
+	case 'X':
+		pad(f, u.StringFormat(FormatUpperHashLike))
+	case 'q':
+		if f.Flag('#') {
+			pad(f, "`"+u.String()+"`")
+			return
+		}
+		pad(f, `"`+u.String()+`"`)
+	default:
+		fmt.Fprintf(f, "%%!%c(uuid.UUID=%s)", verb, u.String())
+	}
+}
+
+// Returns canonical representation followed by version, variant
+// and timestamp embedded in V1 UUID.
+func (u UUID) details() string {
+	s := fmt.Sprintf("%s (version: %d, variant: %s", u.String(), u.Version(), variantName(u.Variant()))
+	if ts, ok := u.v1Timestamp(); ok {
+		// Timestamp counts 100-nanosecond intervals and may lie far
+		// outside of the range representable as int64 nanoseconds.
+		ticks := int64(ts) - epochStart
+		sec, nsec := ticks/1e7, ticks%1e7*100
+		if nsec < 0 {
+			sec, nsec = sec-1, nsec+1e9
+		}
+		t := time.Unix(sec, nsec).UTC()
+		s += ", time: " + t.Format(time.RFC3339Nano)
+	}
+	return s + ")"
+}
+
+// Returns Go syntax representation of UUID.
+func (u UUID) goString() string {
+	buf := make([]byte, 0, 128)
+	buf = append(buf, "uuid.UUID{"...)
+	for i, b := range u {
+		if i > 0 {
+			buf = append(buf, ", "...)
+		}
+		buf = append(buf, '0', 'x', hexDigits[b>>4], hexDigits[b&0x0f])
+	}
+	return string(append(buf, '}'))
+}
+
+// Returns human readable name of UUID layout variant.
+func variantName(v byte) string {
+	switch v {
+	case VariantNCS:
+		return "NCS"
+	case VariantRFC4122:
+		return "RFC4122"
+	case VariantMicrosoft:
+		return "Microsoft"
+	default:
+		return "Future"
+	}
+}
+
+// Writes s padded with spaces to the width requested by f.
+func pad(f fmt.State, s string) {
+	width, ok := f.Width()
+	if !ok || width <= len(s) {
+		io.WriteString(f, s)
+		return
+	}
+
+	padding := strings.Repeat(" ", width-len(s))
+	if f.Flag('-') {
+		io.WriteString(f, s+padding)
+		return
+	}
+	io.WriteString(f, padding+s)
+}
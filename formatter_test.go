@@ -0,0 +1,94 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+type formatterTestSuite struct{}
+
+var _ = Suite(&formatterTestSuite{})
+
+func (s *formatterTestSuite) TestFormat(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{"%s", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"%v", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{"%S", "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"},
+		{"%#s", "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{"%#S", "{6BA7B810-9DAD-11D1-80B4-00C04FD430C8}"},
+		{"%x", "6ba7b8109dad11d180b400c04fd430c8"},
+		{"%X", "6BA7B8109DAD11D180B400C04FD430C8"},
+		{"%q", `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`},
+		{"%#q", "`6ba7b810-9dad-11d1-80b4-00c04fd430c8`"},
+		{"%+v", "6ba7b810-9dad-11d1-80b4-00c04fd430c8 (version: 1, variant: RFC4122, time: 1998-02-04T22:13:53.1511824Z)"},
+		{"%#v", "uuid.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}"},
+		{"%40s|", "    6ba7b810-9dad-11d1-80b4-00c04fd430c8|"},
+		{"%-40s|", "6ba7b810-9dad-11d1-80b4-00c04fd430c8    |"},
+		{"%10x", "6ba7b8109dad11d180b400c04fd430c8"},
+		{"%d", "%!d(uuid.UUID=6ba7b810-9dad-11d1-80b4-00c04fd430c8)"},
+	}
+
+	for _, t := range tests {
+		c.Assert(fmt.Sprintf(t.format, u), Equals, t.expected, Commentf("%s", t.format))
+	}
+}
+
+func (s *formatterTestSuite) TestFormatDetails(c *C) {
+	c.Assert(fmt.Sprintf("%+v", Nil), Equals, "00000000-0000-0000-0000-000000000000 (version: 0, variant: NCS)")
+	c.Assert(fmt.Sprintf("%+v", Max), Equals, "ffffffff-ffff-ffff-ffff-ffffffffffff (version: 15, variant: Future)")
+
+	times := map[string]string{
+		"00000000-0000-1000-8000-000000000000": "1582-10-15T00:00:00Z",
+		"00000001-0000-1000-8000-000000000000": "1582-10-15T00:00:00.0000001Z",
+		"ffffffff-ffff-1fff-8000-000000000000": "5236-03-31T21:21:00.6846975Z",
+	}
+	for input, expected := range times {
+		u, err := FromString(input)
+		c.Assert(err, IsNil)
+		c.Assert(fmt.Sprintf("%+v", u), Equals, input+" (version: 1, variant: RFC4122, time: "+expected+")")
+	}
+
+	u := NewV5(NamespaceDNS, "www.example.com")
+	c.Assert(fmt.Sprintf("%+v", u), Equals, u.String()+" (version: 5, variant: RFC4122)")
+
+	u.SetVariant(VariantMicrosoft)
+	c.Assert(fmt.Sprintf("%+v", u), Equals, u.String()+" (version: 5, variant: Microsoft)")
+}
+
+func (s *formatterTestSuite) TestFormatRoundTrip(c *C) {
+	u, err := NewV4()
+	c.Assert(err, IsNil)
+
+	for _, format := range []string{"%s", "%S", "%#s", "%#S", "%x", "%X"} {
+		u1, err := FromString(fmt.Sprintf(format, u))
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
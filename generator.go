@@ -74,6 +74,12 @@ func NewV5(ns UUID, name string) UUID {
 	return global.NewV5(ns, name)
 }
 
+// NewCOMB returns sequential UUID sorting in order of generation
+// under SQL Server uniqueidentifier ordering.
+func NewCOMB() (UUID, error) {
+	return global.NewCOMB()
+}
+
 // Generator provides interface for generating UUIDs.
 type Generator interface {
 	NewV1() (UUID, error)
@@ -96,9 +102,12 @@ type rfc4122Generator struct {
 	lastTime      uint64
 	clockSequence uint16
 	hardwareAddr  [6]byte
+
+	combLastTime uint64
+	combSequence uint16
 }
 
-func newRFC4122Generator() Generator {
+func newRFC4122Generator() *rfc4122Generator {
 	return &rfc4122Generator{
 		epochFunc:  time.Now,
 		hwAddrFunc: defaultHWAddrFunc,
@@ -183,6 +192,51 @@ func (g *rfc4122Generator) NewV5(ns UUID, name string) UUID {
 	return u
 }
 
+// NewCOMB returns sequential UUID ("COMB") sorting in order of generation
+// under SQL Server uniqueidentifier ordering, as defined by CompareSQLServer.
+// Last 6 bytes hold Unix time in milliseconds, next compared bytes 8-9 hold
+// a counter of UUIDs generated within the same millisecond and the rest is
+// random. Version and variant bits are set as for version 4 UUID.
+func (g *rfc4122Generator) NewCOMB() (UUID, error) {
+	u := UUID{}
+	if _, err := io.ReadFull(g.rand, u[:8]); err != nil {
+		return Nil, err
+	}
+
+	timeNow, seq := g.getCOMBSequence()
+	binary.BigEndian.PutUint16(u[8:], seq)
+	binary.BigEndian.PutUint16(u[10:], uint16(timeNow>>32))
+	binary.BigEndian.PutUint32(u[12:], uint32(timeNow))
+
+	u.SetVersion(V4)
+	u.SetVariant(VariantRFC4122)
+
+	return u, nil
+}
+
+// Returns Unix time in milliseconds and sequence number for COMB UUID.
+// Sequence is 14 bits wide to leave room for variant bits; on overflow
+// time is moved forward by one millisecond to keep UUIDs sorted.
+func (g *rfc4122Generator) getCOMBSequence() (uint64, uint16) {
+	g.storageMutex.Lock()
+	defer g.storageMutex.Unlock()
+
+	timeNow := uint64(g.epochFunc().UnixNano() / int64(time.Millisecond))
+	if timeNow > g.combLastTime {
+		g.combLastTime = timeNow
+		g.combSequence = 0
+		return g.combLastTime, g.combSequence
+	}
+
+	g.combSequence++
+	if g.combSequence > 0x3fff {
+		g.combLastTime++
+		g.combSequence = 0
+	}
+
+	return g.combLastTime, g.combSequence
+}
+
 // Returns epoch and clock sequence.
 func (g *rfc4122Generator) getClockSequence() (uint64, uint16, error) {
 	var err error
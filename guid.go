@@ -0,0 +1,106 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// FromGUIDBytes returns UUID converted from raw byte slice input in
+// Microsoft GUID layout, where Data1, Data2 and Data3 fields (first
+// 4, 2 and 2 bytes) are stored in little-endian byte order.
+// It will return error if the slice isn't 16 bytes long.
+func FromGUIDBytes(input []byte) (u UUID, err error) {
+	if err = u.UnmarshalBinary(input); err != nil {
+		return Nil, err
+	}
+	return u.swapGUID(), nil
+}
+
+// FromGUIDBytesOrNil returns UUID converted from raw byte slice input
+// in Microsoft GUID layout.
+// Same behavior as FromGUIDBytes, but returns a Nil UUID on error.
+func FromGUIDBytesOrNil(input []byte) UUID {
+	uuid, err := FromGUIDBytes(input)
+	if err != nil {
+		return Nil
+	}
+	return uuid
+}
+
+// GUIDBytes returns bytes slice representation of UUID in Microsoft
+// GUID layout, as used by Windows APIs and SQL Server binary columns.
+func (u UUID) GUIDBytes() []byte {
+	g := u.swapGUID()
+	return g[:]
+}
+
+// Returns UUID with byte order of Data1, Data2 and Data3 fields reversed.
+// Applying it twice yields the original UUID.
+func (u UUID) swapGUID() UUID {
+	return UUID{
+		u[3], u[2], u[1], u[0],
+		u[5], u[4],
+		u[7], u[6],
+		u[8], u[9], u[10], u[11], u[12], u[13], u[14], u[15],
+	}
+}
+
+// GUID wraps UUID to be stored in database in Microsoft GUID layout.
+type GUID struct {
+	UUID UUID
+}
+
+// Value implements the driver.Valuer interface.
+// UUID is stored as 16 bytes in Microsoft GUID layout.
+func (g GUID) Value() (driver.Value, error) {
+	return g.UUID.GUIDBytes(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// A 16-byte slice is handled by FromGUIDBytes, while a longer
+// byte slice or a string is handled by UnmarshalText.
+func (g *GUID) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		if len(src) == Size {
+			u, err := FromGUIDBytes(src)
+			if err != nil {
+				return err
+			}
+			g.UUID = u
+			return nil
+		}
+		return g.UUID.UnmarshalText(src)
+
+	case string:
+		return g.UUID.UnmarshalText([]byte(src))
+	}
+
+	return fmt.Errorf("%w: cannot convert %T to GUID", ErrUnsupportedType, src)
+}
+
+// String returns canonical string representation of UUID.
+func (g GUID) String() string {
+	return g.UUID.String()
+}
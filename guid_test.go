@@ -0,0 +1,89 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type guidTestSuite struct{}
+
+var _ = Suite(&guidTestSuite{})
+
+// Bytes of NamespaceDNS as returned by Guid.ToByteArray in .NET.
+var guidDNS = []byte{0x10, 0xb8, 0xa7, 0x6b, 0xad, 0x9d, 0xd1, 0x11, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+func (s *guidTestSuite) TestGUIDBytes(c *C) {
+	c.Assert(bytes.Equal(NamespaceDNS.GUIDBytes(), guidDNS), Equals, true)
+	c.Assert(bytes.Equal(Nil.GUIDBytes(), Nil.Bytes()), Equals, true)
+	c.Assert(bytes.Equal(Max.GUIDBytes(), Max.Bytes()), Equals, true)
+}
+
+func (s *guidTestSuite) TestFromGUIDBytes(c *C) {
+	u, err := FromGUIDBytes(guidDNS)
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	_, err = FromGUIDBytes([]byte{0x10})
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+
+	c.Assert(FromGUIDBytesOrNil(guidDNS), Equals, NamespaceDNS)
+	c.Assert(FromGUIDBytesOrNil(nil), Equals, Nil)
+
+	v4, err := NewV4()
+	c.Assert(err, IsNil)
+	c.Assert(FromGUIDBytesOrNil(v4.GUIDBytes()), Equals, v4)
+}
+
+func (s *guidTestSuite) TestGUIDValue(c *C) {
+	g := GUID{NamespaceDNS}
+	c.Assert(g.String(), Equals, NamespaceDNS.String())
+
+	val, err := g.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, DeepEquals, guidDNS)
+}
+
+func (s *guidTestSuite) TestGUIDScan(c *C) {
+	g := GUID{}
+
+	err := g.Scan(guidDNS)
+	c.Assert(err, IsNil)
+	c.Assert(g.UUID, Equals, NamespaceDNS)
+
+	err = g.Scan("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(g.UUID, Equals, NamespaceURL)
+
+	err = g.Scan([]byte("{6ba7b812-9dad-11d1-80b4-00c04fd430c8}"))
+	c.Assert(err, IsNil)
+	c.Assert(g.UUID, Equals, NamespaceOID)
+
+	err = g.Scan([]byte{0x01})
+	c.Assert(err, NotNil)
+
+	err = g.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+)
+
+// ID is UUID identifying entity of type T. IDs of different entities
+// are distinct types, so mixing them up is a compile-time error:
+//
+//	type User struct {
+//		ID uuid.ID[User]
+//	}
+//
+// ID behaves as UUID when converted to text, JSON or stored in database.
+type ID[T any] UUID
+
+// ParseID returns ID parsed from string input.
+// Input is expected in a form accepted by UnmarshalText.
+// It returns zero ID on error.
+func ParseID[T any](input string) (ID[T], error) {
+	u, err := FromString(input)
+	if err != nil {
+		return ID[T]{}, err
+	}
+	return ID[T](u), nil
+}
+
+// NewV1ID returns ID based on current timestamp and MAC address.
+func NewV1ID[T any]() (ID[T], error) {
+	u, err := NewV1()
+	return ID[T](u), err
+}
+
+// NewV2ID returns DCE Security ID based on POSIX UID/GID.
+func NewV2ID[T any](domain byte) (ID[T], error) {
+	u, err := NewV2(domain)
+	return ID[T](u), err
+}
+
+// NewV3ID returns ID based on MD5 hash of namespace UUID and name.
+func NewV3ID[T any](ns UUID, name string) ID[T] {
+	return ID[T](NewV3(ns, name))
+}
+
+// NewV4ID returns random generated ID.
+func NewV4ID[T any]() (ID[T], error) {
+	u, err := NewV4()
+	return ID[T](u), err
+}
+
+// NewV5ID returns ID based on SHA-1 hash of namespace UUID and name.
+func NewV5ID[T any](ns UUID, name string) ID[T] {
+	return ID[T](NewV5(ns, name))
+}
+
+// UUID returns identifier as UUID.
+func (id ID[T]) UUID() UUID {
+	return UUID(id)
+}
+
+// IsNil returns true if identifier is the Nil UUID, otherwise returns false.
+func (id ID[T]) IsNil() bool {
+	return UUID(id) == Nil
+}
+
+// String returns canonical string representation of identifier.
+func (id ID[T]) String() string {
+	return UUID(id).String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (id ID[T]) MarshalText() ([]byte, error) {
+	return UUID(id).MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (id *ID[T]) UnmarshalText(text []byte) error {
+	return (*UUID)(id).UnmarshalText(text)
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+func (id ID[T]) MarshalBinary() ([]byte, error) {
+	return UUID(id).MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (id *ID[T]) UnmarshalBinary(data []byte) error {
+	return (*UUID)(id).UnmarshalBinary(data)
+}
+
+// Value implements the driver.Valuer interface.
+func (id ID[T]) Value() (driver.Value, error) {
+	return UUID(id).Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (id *ID[T]) Scan(src interface{}) error {
+	return (*UUID)(id).Scan(src)
+}
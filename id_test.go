@@ -0,0 +1,139 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/json"
+	"fmt"
+
+	. "gopkg.in/check.v1"
+)
+
+type idTestSuite struct{}
+
+var _ = Suite(&idTestSuite{})
+
+type testUser struct {
+	ID   ID[testUser]  `json:"id"`
+	Best ID[testOrder] `json:"best"`
+}
+
+type testOrder struct{}
+
+func (s *idTestSuite) TestConstructors(c *C) {
+	id1, err := NewV1ID[testUser]()
+	c.Assert(err, IsNil)
+	c.Assert(id1.UUID().Version(), Equals, V1)
+
+	id2, err := NewV2ID[testUser](DomainPerson)
+	c.Assert(err, IsNil)
+	c.Assert(id2.UUID().Version(), Equals, V2)
+
+	id3 := NewV3ID[testUser](NamespaceDNS, "www.example.com")
+	c.Assert(id3.UUID(), Equals, NewV3(NamespaceDNS, "www.example.com"))
+
+	id4, err := NewV4ID[testUser]()
+	c.Assert(err, IsNil)
+	c.Assert(id4.UUID().Version(), Equals, V4)
+	c.Assert(id4.IsNil(), Equals, false)
+
+	id5 := NewV5ID[testUser](NamespaceDNS, "www.example.com")
+	c.Assert(id5.UUID(), Equals, NewV5(NamespaceDNS, "www.example.com"))
+
+	c.Assert(ID[testUser]{}.IsNil(), Equals, true)
+}
+
+func (s *idTestSuite) TestParseID(c *C) {
+	id, err := ParseID[testUser]("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(id, Equals, ID[testUser](NamespaceDNS))
+	c.Assert(id.String(), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(fmt.Sprint(id), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	invalid := []string{
+		"invalid",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cx",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8>",
+	}
+	for _, input := range invalid {
+		id, err = ParseID[testUser](input)
+		c.Assert(err, NotNil, Commentf("%q", input))
+		c.Assert(id, Equals, ID[testUser]{}, Commentf("%q", input))
+	}
+}
+
+func (s *idTestSuite) TestJSON(c *C) {
+	u := testUser{ID: ID[testUser](NamespaceDNS), Best: ID[testOrder](NamespaceURL)}
+
+	data, err := json.Marshal(u)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","best":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}`)
+
+	var decoded testUser
+	err = json.Unmarshal(data, &decoded)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, Equals, u)
+
+	err = json.Unmarshal([]byte(`{"id":"invalid"}`), &decoded)
+	c.Assert(err, NotNil)
+}
+
+func (s *idTestSuite) TestBinary(c *C) {
+	id := ID[testUser](NamespaceDNS)
+
+	data, err := id.MarshalBinary()
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, NamespaceDNS.Bytes())
+
+	var id1 ID[testUser]
+	err = id1.UnmarshalBinary(data)
+	c.Assert(err, IsNil)
+	c.Assert(id1, Equals, id)
+
+	text, err := id.MarshalText()
+	c.Assert(err, IsNil)
+
+	var id2 ID[testUser]
+	err = id2.UnmarshalText(text)
+	c.Assert(err, IsNil)
+	c.Assert(id2, Equals, id)
+}
+
+func (s *idTestSuite) TestSQL(c *C) {
+	id := ID[testOrder](NamespaceDNS)
+
+	val, err := id.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, NamespaceDNS.String())
+
+	var id1 ID[testOrder]
+	err = id1.Scan(val)
+	c.Assert(err, IsNil)
+	c.Assert(id1, Equals, id)
+
+	err = id1.Scan(NamespaceURL.Bytes())
+	c.Assert(err, IsNil)
+	c.Assert(id1, Equals, ID[testOrder](NamespaceURL))
+
+	err = id1.Scan(42)
+	c.Assert(err, NotNil)
+}
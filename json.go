@@ -0,0 +1,114 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// JSON null literal.
+var jsonNull = []byte("null")
+
+// MarshalJSON implements the json.Marshaler interface.
+// Invalid NullUUID is encoded as null.
+func (u NullUUID) MarshalJSON() ([]byte, error) {
+	if !u.Valid {
+		return jsonNull, nil
+	}
+	return json.Marshal(u.UUID)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and empty string are decoded as invalid NullUUID,
+// any other string is handled by UnmarshalText.
+func (u *NullUUID) UnmarshalJSON(data []byte) error {
+	u.UUID, u.Valid = Nil, false
+
+	if bytes.Equal(data, jsonNull) {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		return nil
+	}
+
+	if err := u.UUID.UnmarshalText([]byte(s)); err != nil {
+		u.UUID = Nil
+		return err
+	}
+	u.Valid = true
+
+	return nil
+}
+
+// NilAsNullUUID wraps UUID to encode Nil UUID as JSON null.
+//
+// Omitting field holding Nil UUID altogether requires Go 1.24 or later,
+// where fields of NilAsNullUUID type tagged with `json:",omitzero"`
+// are omitted, since it implements IsZero. On earlier Go versions
+// such fields are always encoded; store result of Ptr in *UUID field
+// tagged with `json:",omitempty"` instead.
+type NilAsNullUUID struct {
+	UUID UUID
+}
+
+// IsZero returns true if wrapped UUID is the Nil UUID.
+func (u NilAsNullUUID) IsZero() bool {
+	return u.UUID == Nil
+}
+
+// Ptr returns pointer to a copy of wrapped UUID,
+// or nil if it is the Nil UUID.
+func (u NilAsNullUUID) Ptr() *UUID {
+	if u.UUID == Nil {
+		return nil
+	}
+	v := u.UUID
+	return &v
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Nil UUID is encoded as null.
+func (u NilAsNullUUID) MarshalJSON() ([]byte, error) {
+	if u.UUID == Nil {
+		return jsonNull, nil
+	}
+	return json.Marshal(u.UUID)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and empty string are decoded as Nil UUID,
+// any other string is handled by UnmarshalText.
+func (u *NilAsNullUUID) UnmarshalJSON(data []byte) error {
+	var n NullUUID
+	if err := n.UnmarshalJSON(data); err != nil {
+		return err
+	}
+	u.UUID = n.UUID
+
+	return nil
+}
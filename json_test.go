@@ -0,0 +1,146 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type jsonTestSuite struct{}
+
+var _ = Suite(&jsonTestSuite{})
+
+func (s *jsonTestSuite) TestUUID(c *C) {
+	data, err := json.Marshal(NamespaceDNS)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`)
+
+	data, err = json.Marshal(Nil)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"00000000-0000-0000-0000-000000000000"`)
+
+	var u UUID
+	err = json.Unmarshal([]byte(`""`), &u)
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+}
+
+func (s *jsonTestSuite) TestNullUUIDMarshal(c *C) {
+	data, err := json.Marshal(NullUUID{UUID: NamespaceDNS, Valid: true})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`)
+
+	data, err = json.Marshal(NullUUID{UUID: NamespaceDNS})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `null`)
+
+	data, err = json.Marshal(struct {
+		ID *NullUUID `json:"id"`
+	}{&NullUUID{}})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":null}`)
+}
+
+func (s *jsonTestSuite) TestNullUUIDUnmarshal(c *C) {
+	tests := []struct {
+		input string
+		valid bool
+		u     UUID
+	}{
+		{`null`, false, Nil},
+		{`""`, false, Nil},
+		{`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, true, NamespaceDNS},
+		{`"00000000-0000-0000-0000-000000000000"`, true, Nil},
+	}
+
+	for _, t := range tests {
+		u := NullUUID{UUID: NamespaceURL, Valid: true}
+		err := json.Unmarshal([]byte(t.input), &u)
+		c.Assert(err, IsNil)
+		c.Assert(u.Valid, Equals, t.valid, Commentf("%s", t.input))
+		c.Assert(u.UUID, Equals, t.u)
+	}
+
+	var v struct {
+		ID NullUUID `json:"id"`
+	}
+	err := json.Unmarshal([]byte(`{}`), &v)
+	c.Assert(err, IsNil)
+	c.Assert(v.ID.Valid, Equals, false)
+
+	invalid := []string{`"invalid"`, `42`, `{}`}
+	for _, input := range invalid {
+		u := NullUUID{}
+		err := json.Unmarshal([]byte(input), &u)
+		c.Assert(err, NotNil, Commentf("%s", input))
+		c.Assert(u.Valid, Equals, false)
+	}
+}
+
+func (s *jsonTestSuite) TestNilAsNullUUID(c *C) {
+	data, err := json.Marshal(NilAsNullUUID{})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `null`)
+
+	data, err = json.Marshal(NilAsNullUUID{NamespaceDNS})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`)
+
+	c.Assert(NilAsNullUUID{}.IsZero(), Equals, true)
+	c.Assert(NilAsNullUUID{NamespaceDNS}.IsZero(), Equals, false)
+
+	c.Assert(NilAsNullUUID{}.Ptr(), IsNil)
+	c.Assert(*NilAsNullUUID{NamespaceDNS}.Ptr(), Equals, NamespaceDNS)
+
+	inputs := map[string]UUID{
+		`null`:                                   Nil,
+		`""`:                                     Nil,
+		`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`: NamespaceDNS,
+	}
+	for input, expected := range inputs {
+		u := NilAsNullUUID{NamespaceURL}
+		err := json.Unmarshal([]byte(input), &u)
+		c.Assert(err, IsNil)
+		c.Assert(u.UUID, Equals, expected)
+	}
+
+	u := NilAsNullUUID{}
+	err = json.Unmarshal([]byte(`"invalid"`), &u)
+	c.Assert(err, NotNil)
+}
+
+func (s *jsonTestSuite) TestNilAsNullUUIDOmitEmpty(c *C) {
+	type record struct {
+		ID     *UUID         `json:"id,omitempty"`
+		Parent NilAsNullUUID `json:"parent"`
+	}
+
+	data, err := json.Marshal(record{ID: NilAsNullUUID{}.Ptr()})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"parent":null}`)
+
+	data, err = json.Marshal(record{ID: NilAsNullUUID{NamespaceDNS}.Ptr(), Parent: NilAsNullUUID{NamespaceURL}})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":"6ba7b810-9dad-11d1-80b4-00c04fd430c8","parent":"6ba7b811-9dad-11d1-80b4-00c04fd430c8"}`)
+}
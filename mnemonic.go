@@ -0,0 +1,146 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	_ "embed" // for word list
+	"strings"
+)
+
+// Word list used by mnemonic encoding: 256 words of 4 to 6 letters,
+// sorted alphabetically. No two words share the first 4 letters or
+// differ by a single letter, so a single typo never yields another word.
+//
+//go:embed wordlist.txt
+var wordListText string
+
+var (
+	wordList  = strings.Fields(wordListText)
+	wordIndex = func() map[string]byte {
+		index := make(map[string]byte, len(wordList))
+		for i, word := range wordList {
+			index[word] = byte(i)
+		}
+		return index
+	}()
+)
+
+// Number of words in mnemonic representation of UUID:
+// one word per byte followed by checksum word.
+const mnemonicWords = Size + 1
+
+// Mnemonic returns mnemonic representation of UUID: 16 space-separated
+// words from the embedded word list, one per byte, followed by checksum
+// word, e.g. "forest muffin oyster armor ... potato koala".
+func (u UUID) Mnemonic() string {
+	words := make([]string, 0, mnemonicWords)
+	for _, b := range u {
+		words = append(words, wordList[b])
+	}
+	words = append(words, wordList[crc8(u[:])])
+
+	return strings.Join(words, " ")
+}
+
+// FromMnemonic returns UUID parsed from mnemonic representation.
+// Words are case-insensitive and may be separated by any white space
+// or hyphens. Unknown words are reported as *ParseError with
+// ReasonWord and the offset of the word, while a checksum mismatch,
+// such as a valid word typed in place of another or swapped words,
+// is reported with ReasonChecksum.
+func FromMnemonic(input string) (UUID, error) {
+	var buf [mnemonicWords]byte
+	n := 0
+
+	for i := 0; i < len(input); {
+		if input[i] == '-' || isSpace(input[i]) {
+			i++
+			continue
+		}
+
+		start := i
+		for i < len(input) && input[i] != '-' && !isSpace(input[i]) {
+			i++
+		}
+		if n == mnemonicWords {
+			return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+		}
+
+		b, ok := wordIndex[strings.ToLower(input[start:i])]
+		if !ok {
+			return Nil, &ParseError{Input: input, Offset: start, Reason: ReasonWord}
+		}
+		buf[n] = b
+		n++
+	}
+
+	if n != mnemonicWords {
+		return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+	}
+	if crc8(buf[:Size]) != buf[Size] {
+		return Nil, &ParseError{Input: input, Offset: 0, Reason: ReasonChecksum}
+	}
+
+	u := UUID{}
+	copy(u[:], buf[:Size])
+
+	return u, nil
+}
+
+// crc8 returns CRC-8 checksum of data (polynomial x^8 + x^2 + x + 1),
+// which detects any single corrupted byte.
+func crc8(data []byte) byte {
+	var crc byte
+	for _, b := range data {
+		crc ^= b
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// MnemonicUUID wraps UUID to be marshaled as text in
+// mnemonic representation.
+type MnemonicUUID struct {
+	UUID UUID
+}
+
+// String returns mnemonic representation of UUID.
+func (u MnemonicUUID) String() string {
+	return u.UUID.Mnemonic()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (u MnemonicUUID) MarshalText() ([]byte, error) {
+	return []byte(u.UUID.Mnemonic()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (u *MnemonicUUID) UnmarshalText(text []byte) (err error) {
+	u.UUID, err = FromMnemonic(string(text))
+	return
+}
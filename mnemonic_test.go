@@ -0,0 +1,141 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"errors"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type mnemonicTestSuite struct{}
+
+var _ = Suite(&mnemonicTestSuite{})
+
+func (s *mnemonicTestSuite) TestWordList(c *C) {
+	c.Assert(wordList, HasLen, 256)
+	c.Assert(wordIndex, HasLen, 256)
+
+	for i, word := range wordList {
+		c.Assert(word, Equals, strings.ToLower(word))
+		if i > 0 {
+			c.Assert(wordList[i-1] < word, Equals, true)
+			c.Assert(wordList[i-1][:4], Not(Equals), word[:4])
+		}
+	}
+}
+
+func (s *mnemonicTestSuite) TestMnemonic(c *C) {
+	m := NamespaceDNS.Mnemonic()
+	c.Assert(strings.Fields(m), HasLen, 17)
+
+	u, err := FromMnemonic(m)
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	for _, u := range []UUID{Nil, Max} {
+		u1, err := FromMnemonic(u.Mnemonic())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+
+	for i := 0; i < 100; i++ {
+		u, err := NewV4()
+		c.Assert(err, IsNil)
+
+		u1, err := FromMnemonic(u.Mnemonic())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *mnemonicTestSuite) TestFromMnemonicLenient(c *C) {
+	m := NamespaceDNS.Mnemonic()
+
+	inputs := []string{
+		strings.ToUpper(m),
+		strings.Replace(m, " ", "-", -1),
+		"  " + strings.Replace(m, " ", "\n\t", -1) + " ",
+	}
+	for _, input := range inputs {
+		u, err := FromMnemonic(input)
+		c.Assert(err, IsNil, Commentf("%q", input))
+		c.Assert(u, Equals, NamespaceDNS)
+	}
+}
+
+func (s *mnemonicTestSuite) TestFromMnemonicTypos(c *C) {
+	words := strings.Fields(NamespaceDNS.Mnemonic())
+
+	// Any single word replaced by another valid word breaks the checksum.
+	for i := range words {
+		for _, word := range wordList {
+			if word == words[i] {
+				continue
+			}
+			typo := append([]string{}, words...)
+			typo[i] = word
+
+			_, err := FromMnemonic(strings.Join(typo, " "))
+			c.Assert(errors.Is(err, ErrChecksum), Equals, true)
+		}
+	}
+
+	// Misspelled word is reported at its offset.
+	typo := append([]string{}, words...)
+	typo[2] = typo[2][:len(typo[2])-1] + "q"
+	input := strings.Join(typo, " ")
+
+	_, err := FromMnemonic(input)
+	perr, ok := err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Reason, Equals, ReasonWord)
+	c.Assert(perr.Offset, Equals, len(words[0])+len(words[1])+2)
+	c.Assert(errors.Is(err, ErrInvalidCharacter), Equals, true)
+	c.Assert(strings.Contains(err.Error(), "unknown word \""+typo[2]+"\""), Equals, true)
+
+	_, err = FromMnemonic(strings.Join(words[:16], " "))
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+
+	_, err = FromMnemonic(strings.Join(append(words, words[0]), " "))
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+
+	_, err = FromMnemonic("")
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+}
+
+func (s *mnemonicTestSuite) TestMnemonicUUID(c *C) {
+	u := MnemonicUUID{NamespaceURL}
+	c.Assert(u.String(), Equals, NamespaceURL.Mnemonic())
+
+	text, err := u.MarshalText()
+	c.Assert(err, IsNil)
+
+	u1 := MnemonicUUID{}
+	err = u1.UnmarshalText(text)
+	c.Assert(err, IsNil)
+	c.Assert(u1, Equals, u)
+
+	err = u1.UnmarshalText([]byte("acid acid"))
+	c.Assert(err, NotNil)
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// FromMySQLSwappedBytes returns UUID converted from raw byte slice input
+// in the layout produced by MySQL UUID_TO_BIN(uuid, 1), where time-high
+// and time-mid fields are moved in front of time-low field.
+// It will return error if the slice isn't 16 bytes long.
+func FromMySQLSwappedBytes(input []byte) (u UUID, err error) {
+	if err = u.UnmarshalBinary(input); err != nil {
+		return Nil, err
+	}
+	return u.unswapMySQL(), nil
+}
+
+// FromMySQLSwappedBytesOrNil returns UUID converted from raw byte slice
+// input in MySQL UUID_TO_BIN(uuid, 1) layout.
+// Same behavior as FromMySQLSwappedBytes, but returns a Nil UUID on error.
+func FromMySQLSwappedBytesOrNil(input []byte) UUID {
+	uuid, err := FromMySQLSwappedBytes(input)
+	if err != nil {
+		return Nil
+	}
+	return uuid
+}
+
+// MySQLSwappedBytes returns bytes slice representation of UUID in the
+// layout produced by MySQL UUID_TO_BIN(uuid, 1). For V1 UUIDs it puts
+// the most significant timestamp bits first, which improves index locality.
+func (u UUID) MySQLSwappedBytes() []byte {
+	s := u.swapMySQL()
+	return s[:]
+}
+
+// Returns UUID with time-high and time-mid fields moved in front of
+// time-low field, as done by MySQL UUID_TO_BIN(uuid, 1).
+func (u UUID) swapMySQL() UUID {
+	return UUID{
+		u[6], u[7],
+		u[4], u[5],
+		u[0], u[1], u[2], u[3],
+		u[8], u[9], u[10], u[11], u[12], u[13], u[14], u[15],
+	}
+}
+
+// Returns UUID with time-low field moved back in front of time-mid and
+// time-high fields, as done by MySQL BIN_TO_UUID(bin, 1).
+func (u UUID) unswapMySQL() UUID {
+	return UUID{
+		u[4], u[5], u[6], u[7],
+		u[2], u[3],
+		u[0], u[1],
+		u[8], u[9], u[10], u[11], u[12], u[13], u[14], u[15],
+	}
+}
+
+// MySQLSwapped wraps UUID to be stored in MySQL BINARY(16) column in the
+// same layout as produced by UUID_TO_BIN(uuid, 1), so that values written
+// from Go can be read with BIN_TO_UUID(bin, 1) and vice versa.
+type MySQLSwapped struct {
+	UUID UUID
+}
+
+// Value implements the driver.Valuer interface.
+// UUID is stored as 16 bytes in MySQL swapped layout.
+func (m MySQLSwapped) Value() (driver.Value, error) {
+	return m.UUID.MySQLSwappedBytes(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// A 16-byte slice is handled by FromMySQLSwappedBytes, while a longer
+// byte slice or a string is handled by UnmarshalText.
+func (m *MySQLSwapped) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		if len(src) == Size {
+			u, err := FromMySQLSwappedBytes(src)
+			if err != nil {
+				return err
+			}
+			m.UUID = u
+			return nil
+		}
+		return m.UUID.UnmarshalText(src)
+
+	case string:
+		return m.UUID.UnmarshalText([]byte(src))
+	}
+
+	return fmt.Errorf("%w: cannot convert %T to MySQLSwapped", ErrUnsupportedType, src)
+}
+
+// String returns canonical string representation of UUID.
+func (m MySQLSwapped) String() string {
+	return m.UUID.String()
+}
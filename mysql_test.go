@@ -0,0 +1,101 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type mysqlTestSuite struct{}
+
+var _ = Suite(&mysqlTestSuite{})
+
+// Bytes of NamespaceDNS as returned by UUID_TO_BIN(uuid, 1) in MySQL 8.0:
+// UUID_TO_BIN('6ba7b810-9dad-11d1-80b4-00c04fd430c8', 1) = 0x11D19DAD6BA7B81080B400C04FD430C8
+var mysqlSwappedDNS = []byte{0x11, 0xd1, 0x9d, 0xad, 0x6b, 0xa7, 0xb8, 0x10, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+func (s *mysqlTestSuite) TestMySQLSwappedBytes(c *C) {
+	c.Assert(bytes.Equal(NamespaceDNS.MySQLSwappedBytes(), mysqlSwappedDNS), Equals, true)
+	c.Assert(bytes.Equal(Nil.MySQLSwappedBytes(), Nil.Bytes()), Equals, true)
+	c.Assert(bytes.Equal(Max.MySQLSwappedBytes(), Max.Bytes()), Equals, true)
+}
+
+func (s *mysqlTestSuite) TestFromMySQLSwappedBytes(c *C) {
+	u, err := FromMySQLSwappedBytes(mysqlSwappedDNS)
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	_, err = FromMySQLSwappedBytes([]byte{0x11})
+	c.Assert(errors.Is(err, ErrInvalidLength), Equals, true)
+
+	c.Assert(FromMySQLSwappedBytesOrNil(mysqlSwappedDNS), Equals, NamespaceDNS)
+	c.Assert(FromMySQLSwappedBytesOrNil(nil), Equals, Nil)
+
+	v4, err := NewV4()
+	c.Assert(err, IsNil)
+	c.Assert(FromMySQLSwappedBytesOrNil(v4.MySQLSwappedBytes()), Equals, v4)
+}
+
+func (s *mysqlTestSuite) TestMySQLSwappedOrdering(c *C) {
+	// V1 UUIDs generated later sort after earlier ones in swapped layout.
+	u1, err := FromString("ffffffff-0000-11e8-8000-000000000000")
+	c.Assert(err, IsNil)
+	u2, err := FromString("00000000-0001-11e8-8000-000000000000")
+	c.Assert(err, IsNil)
+
+	c.Assert(u2.Less(u1), Equals, true)
+	c.Assert(bytes.Compare(u1.MySQLSwappedBytes(), u2.MySQLSwappedBytes()) < 0, Equals, true)
+}
+
+func (s *mysqlTestSuite) TestMySQLSwappedValue(c *C) {
+	m := MySQLSwapped{NamespaceDNS}
+	c.Assert(m.String(), Equals, NamespaceDNS.String())
+
+	val, err := m.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, DeepEquals, mysqlSwappedDNS)
+}
+
+func (s *mysqlTestSuite) TestMySQLSwappedScan(c *C) {
+	m := MySQLSwapped{}
+
+	err := m.Scan(mysqlSwappedDNS)
+	c.Assert(err, IsNil)
+	c.Assert(m.UUID, Equals, NamespaceDNS)
+
+	err = m.Scan("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(m.UUID, Equals, NamespaceURL)
+
+	err = m.Scan([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	c.Assert(err, IsNil)
+	c.Assert(m.UUID, Equals, NamespaceDNS)
+
+	err = m.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+
+	err = m.Scan([]byte{0x11})
+	c.Assert(err, NotNil)
+}
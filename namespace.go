@@ -0,0 +1,211 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// NamespaceRegistry maps namespace names to namespace UUIDs and
+// resolves "namespace:name" strings into name-based UUIDs.
+// Namespace names are case-insensitive. It is safe for concurrent use.
+type NamespaceRegistry struct {
+	mu         sync.RWMutex
+	namespaces map[string]UUID
+}
+
+// NewNamespaceRegistry returns registry pre-populated with the
+// namespaces predefined by RFC 4122: "dns", "url", "oid" and "x500".
+func NewNamespaceRegistry() *NamespaceRegistry {
+	r := &NamespaceRegistry{
+		namespaces: make(map[string]UUID),
+	}
+	r.namespaces["dns"] = NamespaceDNS
+	r.namespaces["url"] = NamespaceURL
+	r.namespaces["oid"] = NamespaceOID
+	r.namespaces["x500"] = NamespaceX500
+
+	return r
+}
+
+// Register adds namespace UUID under given name.
+// It will return error if name is empty, contains a colon or
+// is already registered with a different UUID.
+func (r *NamespaceRegistry) Register(name string, ns UUID) error {
+	key := strings.ToLower(name)
+	if key == "" || strings.IndexByte(key, ':') >= 0 {
+		return fmt.Errorf("uuid: invalid namespace name %q", name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if existing, ok := r.namespaces[key]; ok && existing != ns {
+		return fmt.Errorf("uuid: namespace %q already registered as %s", name, existing)
+	}
+	r.namespaces[key] = ns
+
+	return nil
+}
+
+// Lookup returns namespace UUID registered under given name.
+func (r *NamespaceRegistry) Lookup(name string) (UUID, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ns, ok := r.namespaces[strings.ToLower(name)]
+	return ns, ok
+}
+
+// Names returns names of all registered namespaces in no particular order.
+func (r *NamespaceRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.namespaces))
+	for name := range r.namespaces {
+		names = append(names, name)
+	}
+	return names
+}
+
+// LoadText registers namespaces read from r. Each non-empty line
+// holds a name and a UUID in any form accepted by UnmarshalText,
+// separated by whitespace or '='. Lines starting with '#' are ignored:
+//
+//	# internal namespaces
+//	billing = 9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23
+//	users     urn:uuid:0e7d5e84-2b1a-4f6c-8d3e-6a1b2c3d4e5f
+func (r *NamespaceRegistry) LoadText(rd io.Reader) error {
+	scanner := bufio.NewScanner(rd)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || text[0] == '#' {
+			continue
+		}
+
+		name, value := splitNamespaceLine(text)
+		if name == "" || value == "" {
+			return fmt.Errorf("uuid: malformed namespace definition at line %d: %q", line, text)
+		}
+		ns, err := FromString(value)
+		if err != nil {
+			return fmt.Errorf("uuid: namespace %q at line %d: %w", name, line, err)
+		}
+		if err = r.Register(name, ns); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// LoadJSON registers namespaces read from r, which is expected
+// to hold a JSON object mapping namespace names to UUID strings:
+//
+//	{"billing": "9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23"}
+func (r *NamespaceRegistry) LoadJSON(rd io.Reader) error {
+	var defs map[string]UUID
+	if err := json.NewDecoder(rd).Decode(&defs); err != nil {
+		return fmt.Errorf("uuid: cannot decode namespaces: %w", err)
+	}
+
+	for name, ns := range defs {
+		if err := r.Register(name, ns); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// LoadFile registers namespaces read from the named file.
+// Files with ".json" extension are handled by LoadJSON,
+// any other file is handled by LoadText.
+func (r *NamespaceRegistry) LoadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		return r.LoadJSON(f)
+	}
+	return r.LoadText(f)
+}
+
+// ResolveV3 returns UUID based on MD5 hash of namespace and name
+// given as "namespace:name" string, e.g. "dns:www.example.com".
+func (r *NamespaceRegistry) ResolveV3(input string) (UUID, error) {
+	ns, name, err := r.resolve(input)
+	if err != nil {
+		return Nil, err
+	}
+	return NewV3(ns, name), nil
+}
+
+// ResolveV5 returns UUID based on SHA-1 hash of namespace and name
+// given as "namespace:name" string, e.g. "url:http://example.com/".
+func (r *NamespaceRegistry) ResolveV5(input string) (UUID, error) {
+	ns, name, err := r.resolve(input)
+	if err != nil {
+		return Nil, err
+	}
+	return NewV5(ns, name), nil
+}
+
+// Returns namespace UUID and name parsed from "namespace:name" string.
+// Only the first colon is treated as separator, so names may contain colons.
+func (r *NamespaceRegistry) resolve(input string) (UUID, string, error) {
+	i := strings.IndexByte(input, ':')
+	if i < 0 {
+		return Nil, "", fmt.Errorf("uuid: missing namespace in %q", input)
+	}
+
+	ns, ok := r.Lookup(input[:i])
+	if !ok {
+		return Nil, "", fmt.Errorf("uuid: unknown namespace %q", input[:i])
+	}
+
+	return ns, input[i+1:], nil
+}
+
+// Returns name and value of "name = value" or "name value" line.
+func splitNamespaceLine(line string) (string, string) {
+	if i := strings.IndexByte(line, '='); i >= 0 {
+		return strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+	}
+
+	fields := strings.Fields(line)
+	if len(fields) != 2 {
+		return "", ""
+	}
+	return fields[0], fields[1]
+}
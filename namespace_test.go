@@ -0,0 +1,151 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	. "gopkg.in/check.v1"
+)
+
+type namespaceTestSuite struct{}
+
+var _ = Suite(&namespaceTestSuite{})
+
+func (s *namespaceTestSuite) TestPredefined(c *C) {
+	r := NewNamespaceRegistry()
+
+	names := r.Names()
+	sort.Strings(names)
+	c.Assert(names, DeepEquals, []string{"dns", "oid", "url", "x500"})
+
+	ns, ok := r.Lookup("DNS")
+	c.Assert(ok, Equals, true)
+	c.Assert(ns, Equals, NamespaceDNS)
+
+	_, ok = r.Lookup("unknown")
+	c.Assert(ok, Equals, false)
+}
+
+func (s *namespaceTestSuite) TestRegister(c *C) {
+	r := NewNamespaceRegistry()
+	ns := Must(FromString("9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23"))
+
+	c.Assert(r.Register("billing", ns), IsNil)
+	c.Assert(r.Register("Billing", ns), IsNil)
+	c.Assert(r.Register("billing", NamespaceURL), NotNil)
+	c.Assert(r.Register("", ns), NotNil)
+	c.Assert(r.Register("a:b", ns), NotNil)
+
+	u, ok := r.Lookup("billing")
+	c.Assert(ok, Equals, true)
+	c.Assert(u, Equals, ns)
+}
+
+func (s *namespaceTestSuite) TestResolve(c *C) {
+	r := NewNamespaceRegistry()
+
+	u3, err := r.ResolveV3("dns:www.example.com")
+	c.Assert(err, IsNil)
+	c.Assert(u3, Equals, NewV3(NamespaceDNS, "www.example.com"))
+
+	u5, err := r.ResolveV5("url:http://example.com/")
+	c.Assert(err, IsNil)
+	c.Assert(u5, Equals, NewV5(NamespaceURL, "http://example.com/"))
+
+	_, err = r.ResolveV5("www.example.com")
+	c.Assert(err, NotNil)
+
+	_, err = r.ResolveV3("unknown:www.example.com")
+	c.Assert(err, NotNil)
+}
+
+func (s *namespaceTestSuite) TestLoadText(c *C) {
+	r := NewNamespaceRegistry()
+	text := `
+# internal namespaces
+billing = 9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23
+users     urn:uuid:0e7d5e84-2b1a-4f6c-8d3e-6a1b2c3d4e5f
+`
+	c.Assert(r.LoadText(strings.NewReader(text)), IsNil)
+
+	ns, ok := r.Lookup("users")
+	c.Assert(ok, Equals, true)
+	c.Assert(ns.String(), Equals, "0e7d5e84-2b1a-4f6c-8d3e-6a1b2c3d4e5f")
+
+	_, ok = r.Lookup("billing")
+	c.Assert(ok, Equals, true)
+}
+
+func (s *namespaceTestSuite) TestLoadTextInvalid(c *C) {
+	inputs := []string{
+		"billing",
+		"billing 9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23 extra",
+		"billing = not-a-uuid",
+		"dns = 9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23",
+	}
+
+	for _, input := range inputs {
+		r := NewNamespaceRegistry()
+		c.Assert(r.LoadText(strings.NewReader(input)), NotNil)
+	}
+}
+
+func (s *namespaceTestSuite) TestLoadJSON(c *C) {
+	r := NewNamespaceRegistry()
+	c.Assert(r.LoadJSON(strings.NewReader(`{"billing": "9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23"}`)), IsNil)
+
+	ns, ok := r.Lookup("billing")
+	c.Assert(ok, Equals, true)
+	c.Assert(ns.String(), Equals, "9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23")
+
+	c.Assert(r.LoadJSON(strings.NewReader(`{"billing": "invalid"}`)), NotNil)
+	c.Assert(r.LoadJSON(strings.NewReader(`[]`)), NotNil)
+}
+
+func (s *namespaceTestSuite) TestLoadFile(c *C) {
+	dir, err := ioutil.TempDir("", "uuid")
+	c.Assert(err, IsNil)
+	defer os.RemoveAll(dir)
+
+	jsonPath := filepath.Join(dir, "namespaces.json")
+	err = ioutil.WriteFile(jsonPath, []byte(`{"billing": "9c4b2f1e-31c6-4d2a-9a8e-4d5c7a0b1e23"}`), 0600)
+	c.Assert(err, IsNil)
+
+	textPath := filepath.Join(dir, "namespaces.txt")
+	err = ioutil.WriteFile(textPath, []byte("users 0e7d5e84-2b1a-4f6c-8d3e-6a1b2c3d4e5f\n"), 0600)
+	c.Assert(err, IsNil)
+
+	r := NewNamespaceRegistry()
+	c.Assert(r.LoadFile(jsonPath), IsNil)
+	c.Assert(r.LoadFile(textPath), IsNil)
+	c.Assert(r.LoadFile(filepath.Join(dir, "missing")), NotNil)
+
+	_, ok := r.Lookup("billing")
+	c.Assert(ok, Equals, true)
+	_, ok = r.Lookup("users")
+	c.Assert(ok, Equals, true)
+}
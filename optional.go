@@ -0,0 +1,182 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Optional represents UUID value that may be absent. Unlike NullUUID,
+// which is meant for database/sql only, it also supports text, JSON,
+// XML and gob encodings. Absent value is represented as null in JSON
+// and SQL, as empty text in text and XML, and as empty data in binary
+// and gob encodings. Empty string input is decoded as absent value
+// in all the encodings, while Nil UUID is a valid present value.
+type Optional struct {
+	UUID  UUID
+	Valid bool // Valid is true if UUID is present
+}
+
+// OptionalOf returns present Optional holding u.
+func OptionalOf(u UUID) Optional {
+	return Optional{UUID: u, Valid: true}
+}
+
+// OptionalFromPtr returns Optional holding value pointed to by u,
+// or absent Optional if u is nil.
+func OptionalFromPtr(u *UUID) Optional {
+	if u == nil {
+		return Optional{}
+	}
+	return OptionalOf(*u)
+}
+
+// Ptr returns pointer to a copy of UUID if present, otherwise returns nil.
+func (o Optional) Ptr() *UUID {
+	if !o.Valid {
+		return nil
+	}
+	u := o.UUID
+	return &u
+}
+
+// String returns canonical string representation of UUID if present,
+// otherwise returns empty string.
+func (o Optional) String() string {
+	if !o.Valid {
+		return ""
+	}
+	return o.UUID.String()
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Absent value is encoded as empty text.
+func (o Optional) MarshalText() ([]byte, error) {
+	if !o.Valid {
+		return []byte{}, nil
+	}
+	return o.UUID.MarshalText()
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Empty text is decoded as absent value.
+func (o *Optional) UnmarshalText(text []byte) error {
+	o.UUID, o.Valid = Nil, false
+	if len(text) == 0 {
+		return nil
+	}
+
+	if err := o.UUID.UnmarshalText(text); err != nil {
+		o.UUID = Nil
+		return err
+	}
+	o.Valid = true
+
+	return nil
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// Absent value is encoded as empty data.
+func (o Optional) MarshalBinary() ([]byte, error) {
+	if !o.Valid {
+		return []byte{}, nil
+	}
+	return o.UUID.MarshalBinary()
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// Empty data is decoded as absent value.
+func (o *Optional) UnmarshalBinary(data []byte) error {
+	o.UUID, o.Valid = Nil, false
+	if len(data) == 0 {
+		return nil
+	}
+
+	if err := o.UUID.UnmarshalBinary(data); err != nil {
+		return err
+	}
+	o.Valid = true
+
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Absent value is encoded as null.
+func (o Optional) MarshalJSON() ([]byte, error) {
+	if !o.Valid {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.UUID)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// Both null and empty string are decoded as absent value.
+func (o *Optional) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, jsonNull) {
+		o.UUID, o.Valid = Nil, false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return o.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+// Absent value is stored as NULL.
+func (o Optional) Value() (driver.Value, error) {
+	if !o.Valid {
+		return nil, nil
+	}
+	return o.UUID.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+// Both NULL and empty string are scanned as absent value.
+func (o *Optional) Scan(src interface{}) error {
+	o.UUID, o.Valid = Nil, false
+
+	switch src := src.(type) {
+	case nil:
+		return nil
+	case string:
+		if src == "" {
+			return nil
+		}
+	case []byte:
+		if len(src) == 0 {
+			return nil
+		}
+	}
+
+	if err := o.UUID.Scan(src); err != nil {
+		o.UUID = Nil
+		return err
+	}
+	o.Valid = true
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+
+	. "gopkg.in/check.v1"
+)
+
+type optionalTestSuite struct{}
+
+var _ = Suite(&optionalTestSuite{})
+
+func (s *optionalTestSuite) TestPtr(c *C) {
+	c.Assert(OptionalFromPtr(nil), Equals, Optional{})
+	c.Assert(Optional{}.Ptr(), IsNil)
+
+	u := NamespaceDNS
+	o := OptionalFromPtr(&u)
+	c.Assert(o, Equals, OptionalOf(NamespaceDNS))
+
+	p := o.Ptr()
+	c.Assert(*p, Equals, NamespaceDNS)
+	*p = NamespaceURL
+	c.Assert(o.UUID, Equals, NamespaceDNS)
+}
+
+func (s *optionalTestSuite) TestString(c *C) {
+	c.Assert(Optional{}.String(), Equals, "")
+	c.Assert(OptionalOf(Nil).String(), Equals, "00000000-0000-0000-0000-000000000000")
+	c.Assert(OptionalOf(NamespaceDNS).String(), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+}
+
+func (s *optionalTestSuite) TestText(c *C) {
+	text, err := Optional{}.MarshalText()
+	c.Assert(err, IsNil)
+	c.Assert(text, HasLen, 0)
+
+	text, err = OptionalOf(NamespaceDNS).MarshalText()
+	c.Assert(err, IsNil)
+	c.Assert(string(text), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	o := OptionalOf(NamespaceURL)
+	err = o.UnmarshalText([]byte{})
+	c.Assert(err, IsNil)
+	c.Assert(o, Equals, Optional{})
+
+	err = o.UnmarshalText([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	c.Assert(err, IsNil)
+	c.Assert(o, Equals, OptionalOf(NamespaceDNS))
+
+	err = o.UnmarshalText([]byte("invalid"))
+	c.Assert(err, NotNil)
+	c.Assert(o, Equals, Optional{})
+}
+
+func (s *optionalTestSuite) TestBinary(c *C) {
+	data, err := Optional{}.MarshalBinary()
+	c.Assert(err, IsNil)
+	c.Assert(data, HasLen, 0)
+
+	data, err = OptionalOf(NamespaceDNS).MarshalBinary()
+	c.Assert(err, IsNil)
+	c.Assert(data, DeepEquals, NamespaceDNS.Bytes())
+
+	o := OptionalOf(NamespaceURL)
+	err = o.UnmarshalBinary(nil)
+	c.Assert(err, IsNil)
+	c.Assert(o, Equals, Optional{})
+
+	err = o.UnmarshalBinary(NamespaceDNS.Bytes())
+	c.Assert(err, IsNil)
+	c.Assert(o, Equals, OptionalOf(NamespaceDNS))
+
+	err = o.UnmarshalBinary([]byte{1, 2, 3})
+	c.Assert(err, NotNil)
+	c.Assert(o, Equals, Optional{})
+}
+
+func (s *optionalTestSuite) TestJSON(c *C) {
+	data, err := json.Marshal(Optional{})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `null`)
+
+	data, err = json.Marshal(OptionalOf(NamespaceDNS))
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`)
+
+	tests := []struct {
+		input string
+		o     Optional
+	}{
+		{`null`, Optional{}},
+		{`""`, Optional{}},
+		{`"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, OptionalOf(NamespaceDNS)},
+		{`"00000000-0000-0000-0000-000000000000"`, OptionalOf(Nil)},
+	}
+	for _, t := range tests {
+		o := OptionalOf(NamespaceURL)
+		err := json.Unmarshal([]byte(t.input), &o)
+		c.Assert(err, IsNil)
+		c.Assert(o, Equals, t.o, Commentf("%s", t.input))
+	}
+
+	invalid := []string{`"invalid"`, `42`, `{}`}
+	for _, input := range invalid {
+		o := Optional{}
+		err := json.Unmarshal([]byte(input), &o)
+		c.Assert(err, NotNil, Commentf("%s", input))
+		c.Assert(o.Valid, Equals, false)
+	}
+}
+
+func (s *optionalTestSuite) TestXML(c *C) {
+	type record struct {
+		XMLName xml.Name `xml:"record"`
+		ID      Optional `xml:"id,attr"`
+		Parent  Optional `xml:"parent"`
+	}
+
+	data, err := xml.Marshal(record{ID: OptionalOf(NamespaceDNS)})
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `<record id="6ba7b810-9dad-11d1-80b4-00c04fd430c8"><parent></parent></record>`)
+
+	var r record
+	err = xml.Unmarshal(data, &r)
+	c.Assert(err, IsNil)
+	c.Assert(r.ID, Equals, OptionalOf(NamespaceDNS))
+	c.Assert(r.Parent, Equals, Optional{})
+
+	err = xml.Unmarshal([]byte(`<record id="invalid"></record>`), &r)
+	c.Assert(err, NotNil)
+}
+
+func (s *optionalTestSuite) TestGob(c *C) {
+	inputs := []Optional{{}, OptionalOf(Nil), OptionalOf(NamespaceDNS)}
+	for _, input := range inputs {
+		var buf bytes.Buffer
+		err := gob.NewEncoder(&buf).Encode(input)
+		c.Assert(err, IsNil)
+
+		o := OptionalOf(NamespaceURL)
+		err = gob.NewDecoder(&buf).Decode(&o)
+		c.Assert(err, IsNil)
+		c.Assert(o, Equals, input)
+	}
+}
+
+func (s *optionalTestSuite) TestValue(c *C) {
+	val, err := Optional{}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, IsNil)
+
+	val, err = OptionalOf(NamespaceDNS).Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, NamespaceDNS.String())
+}
+
+func (s *optionalTestSuite) TestScan(c *C) {
+	tests := []struct {
+		src interface{}
+		o   Optional
+	}{
+		{nil, Optional{}},
+		{"", Optional{}},
+		{[]byte{}, Optional{}},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", OptionalOf(NamespaceDNS)},
+		{[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), OptionalOf(NamespaceDNS)},
+		{NamespaceDNS.Bytes(), OptionalOf(NamespaceDNS)},
+	}
+	for _, t := range tests {
+		o := OptionalOf(NamespaceURL)
+		err := o.Scan(t.src)
+		c.Assert(err, IsNil)
+		c.Assert(o, Equals, t.o, Commentf("%v", t.src))
+	}
+
+	invalid := []interface{}{"invalid", []byte{1, 2, 3}, 42}
+	for _, src := range invalid {
+		o := OptionalOf(NamespaceURL)
+		err := o.Scan(src)
+		c.Assert(err, NotNil, Commentf("%v", src))
+		c.Assert(o, Equals, Optional{})
+	}
+}
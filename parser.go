@@ -0,0 +1,202 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+// Parser parses UUID text representations with configurable strictness.
+// Zero value accepts formats accepted by UnmarshalText, as well as
+// braced hash-like format "{6ba7b8109dad11d180b400c04fd430c8}".
+type Parser struct {
+	// CanonicalOnly accepts lower-case canonical format only, as
+	// produced by String: "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+	// This is the same format Validator.RequireCanonical enforces.
+	CanonicalOnly bool
+
+	// TrimSpace ignores leading and trailing white space.
+	TrimSpace bool
+
+	// FoldURNPrefix accepts "urn:uuid:" prefix in any case.
+	FoldURNPrefix bool
+
+	// AllowHexPrefix accepts "0x" or "0X" prefix before hash-like format.
+	AllowHexPrefix bool
+
+	// IgnoreDashes accepts dashes at any position, as long as
+	// 32 hex digits remain after removing them.
+	IgnoreDashes bool
+
+	// AllowBase64 accepts 22 characters long unpadded URL-safe
+	// Base64 representation "a6e4EJ2tEdGAtADAT9QwyA".
+	AllowBase64 bool
+}
+
+// Predefined parsers.
+var (
+	// StrictParser accepts lower-case canonical format only.
+	StrictParser = Parser{CanonicalOnly: true}
+
+	// LenientParser accepts any format accepted by UnmarshalText,
+	// surrounded by white space, with "urn:uuid:" prefix in any case,
+	// "0x" prefix or misplaced dashes.
+	LenientParser = Parser{
+		TrimSpace:      true,
+		FoldURNPrefix:  true,
+		AllowHexPrefix: true,
+		IgnoreDashes:   true,
+	}
+)
+
+// FromStringStrict returns UUID parsed from string input
+// in lower-case canonical format only.
+func FromStringStrict(input string) (UUID, error) {
+	return StrictParser.FromString(input)
+}
+
+// FromStringLenient returns UUID parsed from string input
+// in any format accepted by LenientParser.
+func FromStringLenient(input string) (UUID, error) {
+	return LenientParser.FromString(input)
+}
+
+// FromString returns UUID parsed from string input.
+// Malformed input is reported as *ParseError.
+func (p Parser) FromString(input string) (UUID, error) {
+	return p.Parse([]byte(input))
+}
+
+// Parse returns UUID parsed from byte slice input.
+// Malformed input is reported as *ParseError.
+func (p Parser) Parse(input []byte) (u UUID, err error) {
+	if offset, reason := p.decode(&u, input); reason != 0 {
+		return Nil, &ParseError{Input: string(input), Offset: offset, Reason: reason}
+	}
+	return u, nil
+}
+
+// decode decodes UUID from t. On failure it returns offset of
+// the offending byte in t and reason of the failure.
+func (p Parser) decode(u *UUID, t []byte) (int, ParseErrorReason) {
+	start, end := 0, len(t)
+	if p.TrimSpace {
+		for start < end && isSpace(t[start]) {
+			start++
+		}
+		for end > start && isSpace(t[end-1]) {
+			end--
+		}
+	}
+
+	if p.CanonicalOnly {
+		if end-start != 36 {
+			return len(t), ReasonLength
+		}
+		offset, reason := u.decodeCanonical(t[start:end])
+		limit := end
+		if reason != 0 {
+			limit = start + offset
+		}
+		for i := start; i < limit; i++ {
+			if 'A' <= t[i] && t[i] <= 'F' {
+				return i, ReasonCharacter
+			}
+		}
+		return start + offset, reason
+	}
+
+	if p.AllowBase64 && end-start == base64Len {
+		offset, reason := u.decodeBase64(t[start:end])
+		return start + offset, reason
+	}
+
+	switch {
+	case end-start >= len(urnPrefix) && p.hasURNPrefix(t[start:end]):
+		start += len(urnPrefix)
+	case end-start >= 2 && t[start] == '{':
+		if t[end-1] != '}' {
+			return end - 1, ReasonFormat
+		}
+		start++
+		end--
+	}
+
+	if p.AllowHexPrefix && end-start >= 2 && t[start] == '0' && (t[start+1] == 'x' || t[start+1] == 'X') {
+		start += 2
+	}
+
+	if p.IgnoreDashes {
+		return decodeIgnoringDashes(u, t, start, end)
+	}
+
+	offset, reason := u.decodePlain(t[start:end])
+	if reason == ReasonLength {
+		return len(t), reason
+	}
+	return start + offset, reason
+}
+
+// Returns true if t starts with "urn:uuid:" prefix.
+func (p Parser) hasURNPrefix(t []byte) bool {
+	for i, c := range urnPrefix {
+		if t[i] == c || p.FoldURNPrefix && 'A' <= t[i] && t[i] <= 'Z' && t[i]+('a'-'A') == c {
+			continue
+		}
+		return false
+	}
+	return true
+}
+
+// decodeIgnoringDashes decodes 32 hex digits found in t[start:end],
+// skipping any dashes between them.
+func decodeIgnoringDashes(u *UUID, t []byte, start, end int) (int, ParseErrorReason) {
+	var buf [32]byte
+	n := 0
+
+	for i := start; i < end; i++ {
+		c := t[i]
+		if c == '-' {
+			continue
+		}
+		if hexTable[c] == 0xff {
+			return i, ReasonCharacter
+		}
+		if n == len(buf) {
+			return len(t), ReasonLength
+		}
+		buf[n] = c
+		n++
+	}
+
+	if n != len(buf) {
+		return len(t), ReasonLength
+	}
+
+	decodeHex(u[:], buf[:])
+	return 0, 0
+}
+
+// Returns true if c is an ASCII white space character.
+func isSpace(c byte) bool {
+	switch c {
+	case ' ', '\t', '\n', '\v', '\f', '\r':
+		return true
+	}
+	return false
+}
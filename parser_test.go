@@ -0,0 +1,170 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type parserTestSuite struct{}
+
+var _ = Suite(&parserTestSuite{})
+
+func (s *parserTestSuite) TestStrict(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	u1, err := FromStringStrict("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(u1, Equals, u)
+
+	// Canonical format is lower case, same as for Validator.RequireCanonical.
+	_, err = FromStringStrict("6ba7b810-9dAd-11d1-80b4-00c04fd430c8")
+	perr, ok := err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Offset, Equals, 11)
+	c.Assert(perr.Reason, Equals, ReasonCharacter)
+
+	_, err = FromStringStrict("6BA7B810+9DAD-11D1-80B4-00C04FD430C8")
+	perr, ok = err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Offset, Equals, 1)
+	c.Assert(perr.Reason, Equals, ReasonCharacter)
+
+	for _, input := range []string{"6ba7b810-9dad-11d1-80b4-00c04fd430c8", "6BA7B810-9DAD-11D1-80B4-00C04FD430C8"} {
+		_, strictErr := FromStringStrict(input)
+		_, validErr := Validator{RequireCanonical: true}.FromString(input)
+		c.Assert(strictErr == nil, Equals, validErr == nil, Commentf("%q", input))
+	}
+
+	invalid := []string{
+		"",
+		" 6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+		"6ba7b8109-dad-11d1-80b4-00c04fd430c8",
+		"6ba7b810-9dad-11d1-80b4-00c04fd430cx",
+		"6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+	}
+	for _, input := range invalid {
+		_, err := FromStringStrict(input)
+		c.Assert(err, FitsTypeOf, &ParseError{}, Commentf("%q", input))
+	}
+}
+
+func (s *parserTestSuite) TestLenient(c *C) {
+	u := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+
+	valid := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"  6ba7b810-9dad-11d1-80b4-00c04fd430c8\n",
+		"\t{6ba7b810-9dad-11d1-80b4-00c04fd430c8} ",
+		"{6ba7b8109dad11d180b400c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"URN:UUID:6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		"Urn:Uuid:6ba7b8109dad11d180b400c04fd430c8",
+		"0x6ba7b8109dad11d180b400c04fd430c8",
+		"0X6BA7B8109DAD11D180B400C04FD430C8",
+		"6ba7b8109-dad-11d1-80b4-00c04fd430c8",
+		"6ba7-b810-9dad-11d1-80b4-00c0-4fd4-30c8",
+		"-6ba7b8109dad11d180b400c04fd430c8-",
+	}
+	for _, input := range valid {
+		u1, err := FromStringLenient(input)
+		c.Assert(err, IsNil, Commentf("%q", input))
+		c.Assert(u1, Equals, u)
+	}
+
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonLength},
+		{"   ", 3, ReasonLength},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c", 35, ReasonLength},
+		{"6ba7b810-9dad-11d1-80b4-00c04fd430c8a", 37, ReasonLength},
+		{" 6ba7b810-9dad-11d1-80b4-00c04fd430cx", 36, ReasonCharacter},
+		{"6ba7b810 9dad-11d1-80b4-00c04fd430c8", 8, ReasonCharacter},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8>", 37, ReasonFormat},
+		{"urn:uuid:{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", 9, ReasonCharacter},
+		{"uuid:urn:6ba7b810-9dad-11d1-80b4-00c04fd430c8", 0, ReasonCharacter},
+	}
+	for _, t := range tests {
+		_, err := FromStringLenient(t.input)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
+
+func (s *parserTestSuite) TestZeroParser(c *C) {
+	p := Parser{}
+
+	valid := []string{
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}",
+		"{6ba7b8109dad11d180b400c04fd430c8}",
+		"urn:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"6ba7b8109dad11d180b400c04fd430c8",
+	}
+	for _, input := range valid {
+		u, err := p.FromString(input)
+		c.Assert(err, IsNil, Commentf("%q", input))
+		c.Assert(u, Equals, NamespaceDNS)
+	}
+
+	invalid := []string{
+		" 6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"URN:UUID:6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+		"0x6ba7b8109dad11d180b400c04fd430c8",
+		"6ba7b8109-dad-11d1-80b4-00c04fd430c8",
+	}
+	for _, input := range invalid {
+		_, err := p.FromString(input)
+		c.Assert(err, NotNil, Commentf("%q", input))
+	}
+}
+
+func (s *parserTestSuite) TestParserOptions(c *C) {
+	p := Parser{TrimSpace: true, CanonicalOnly: true}
+
+	u, err := p.Parse([]byte(" 6ba7b810-9dad-11d1-80b4-00c04fd430c8 "))
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	_, err = p.Parse([]byte(" 6ba7b810+9dad-11d1-80b4-00c04fd430c8 "))
+	perr, ok := err.(*ParseError)
+	c.Assert(ok, Equals, true)
+	c.Assert(perr.Offset, Equals, 9)
+	c.Assert(perr.Reason, Equals, ReasonFormat)
+
+	p = Parser{FoldURNPrefix: true}
+	u, err = p.FromString("URN:uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	_, err = p.FromString("urn\x1auuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, NotNil)
+}
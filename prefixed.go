@@ -0,0 +1,122 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// Prefix defines prefix of PrefixedID. It is meant to be implemented
+// by an empty struct type, one per kind of identifier:
+//
+//	type UserPrefix struct{}
+//
+//	func (UserPrefix) Prefix() string { return "usr" }
+//
+//	type UserID = uuid.PrefixedID[UserPrefix]
+type Prefix interface {
+	Prefix() string
+}
+
+// Separator between prefix and encoded UUID in PrefixedID.
+const prefixSeparator = '_'
+
+// PrefixedID is UUID represented as text with a prefix identifying
+// its kind followed by '_' and lower-case Crockford's Base32
+// representation of UUID, e.g. "usr_3bmyw117dd278r1d00r17x8c68".
+// Representations of identifiers of the same kind sort the same
+// way as UUIDs do.
+type PrefixedID[P Prefix] struct {
+	UUID UUID
+}
+
+// ParsePrefixedID returns PrefixedID parsed from string input.
+// It will return *ParseError if input has different prefix or
+// malformed Base32 representation.
+func ParsePrefixedID[P Prefix](input string) (id PrefixedID[P], err error) {
+	err = id.UnmarshalText([]byte(input))
+	return
+}
+
+// Prefix returns prefix of identifier.
+func (id PrefixedID[P]) Prefix() string {
+	var p P
+	return p.Prefix()
+}
+
+// String returns prefixed text representation of identifier.
+func (id PrefixedID[P]) String() string {
+	prefix := id.Prefix()
+	if prefix == "" {
+		return strings.ToLower(id.UUID.Base32())
+	}
+	return prefix + string(prefixSeparator) + strings.ToLower(id.UUID.Base32())
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (id PrefixedID[P]) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (id *PrefixedID[P]) UnmarshalText(text []byte) error {
+	input := string(text)
+
+	offset := 0
+	if prefix := id.Prefix(); prefix != "" {
+		expected := prefix + string(prefixSeparator)
+		for i := 0; i < len(expected); i++ {
+			if i == len(input) || input[i] != expected[i] {
+				return &ParseError{Input: input, Offset: i, Reason: ReasonFormat}
+			}
+		}
+		offset = len(expected)
+	}
+
+	u, err := FromBase32(input[offset:])
+	if err != nil {
+		perr := err.(*ParseError)
+		return &ParseError{Input: input, Offset: offset + perr.Offset, Reason: perr.Reason}
+	}
+	id.UUID = u
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+func (id PrefixedID[P]) Value() (driver.Value, error) {
+	return id.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (id *PrefixedID[P]) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case []byte:
+		return id.UnmarshalText(src)
+	case string:
+		return id.UnmarshalText([]byte(src))
+	}
+
+	return fmt.Errorf("%w: cannot convert %T to %s identifier", ErrUnsupportedType, src, id.Prefix())
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type prefixedTestSuite struct{}
+
+var _ = Suite(&prefixedTestSuite{})
+
+type userPrefix struct{}
+
+func (userPrefix) Prefix() string { return "usr" }
+
+type orderPrefix struct{}
+
+func (orderPrefix) Prefix() string { return "ord" }
+
+type emptyPrefix struct{}
+
+func (emptyPrefix) Prefix() string { return "" }
+
+func (s *prefixedTestSuite) TestString(c *C) {
+	id := PrefixedID[userPrefix]{NamespaceDNS}
+	c.Assert(id.Prefix(), Equals, "usr")
+	c.Assert(id.String(), Equals, "usr_3bmyw117dd278r1d00r17x8c68")
+
+	c.Assert(PrefixedID[emptyPrefix]{NamespaceDNS}.String(), Equals, "3bmyw117dd278r1d00r17x8c68")
+}
+
+func (s *prefixedTestSuite) TestParse(c *C) {
+	id, err := ParsePrefixedID[userPrefix]("usr_3bmyw117dd278r1d00r17x8c68")
+	c.Assert(err, IsNil)
+	c.Assert(id.UUID, Equals, NamespaceDNS)
+
+	id, err = ParsePrefixedID[userPrefix]("usr_3BMYW117DD278R1D00R17X8C68")
+	c.Assert(err, IsNil)
+	c.Assert(id.UUID, Equals, NamespaceDNS)
+
+	id2, err := ParsePrefixedID[emptyPrefix]("3bmyw117dd278r1d00r17x8c68")
+	c.Assert(err, IsNil)
+	c.Assert(id2.UUID, Equals, NamespaceDNS)
+
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonFormat},
+		{"usr", 3, ReasonFormat},
+		{"ord_3bmyw117dd278r1d00r17x8c68", 0, ReasonFormat},
+		{"usr-3bmyw117dd278r1d00r17x8c68", 3, ReasonFormat},
+		{"usr_", 4, ReasonLength},
+		{"usr_3bmyw117dd278r1d00r17x8c6u", 29, ReasonCharacter},
+		{"usr_6ba7b810-9dad-11d1-80b4-00c04fd430c8", 40, ReasonLength},
+	}
+	for _, t := range tests {
+		id, err := ParsePrefixedID[userPrefix](t.input)
+		c.Assert(id.UUID, Equals, Nil)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Input, Equals, t.input)
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
+
+func (s *prefixedTestSuite) TestJSON(c *C) {
+	type order struct {
+		ID   PrefixedID[orderPrefix] `json:"id"`
+		User PrefixedID[userPrefix]  `json:"user"`
+	}
+
+	o := order{PrefixedID[orderPrefix]{NamespaceURL}, PrefixedID[userPrefix]{NamespaceDNS}}
+	data, err := json.Marshal(o)
+	c.Assert(err, IsNil)
+	c.Assert(string(data), Equals, `{"id":"ord_3bmyw137dd278r1d00r17x8c68","user":"usr_3bmyw117dd278r1d00r17x8c68"}`)
+
+	var decoded order
+	err = json.Unmarshal(data, &decoded)
+	c.Assert(err, IsNil)
+	c.Assert(decoded, Equals, o)
+
+	err = json.Unmarshal([]byte(`{"id":"usr_3bmyw117dd278r1d00r17x8c69"}`), &decoded)
+	c.Assert(errors.Is(err, ErrInvalidFormat), Equals, true)
+
+	err = json.Unmarshal([]byte(`{"id":42}`), &decoded)
+	c.Assert(err, NotNil)
+}
+
+func (s *prefixedTestSuite) TestSQL(c *C) {
+	id := PrefixedID[userPrefix]{NamespaceDNS}
+
+	val, err := id.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "usr_3bmyw117dd278r1d00r17x8c68")
+
+	id1 := PrefixedID[userPrefix]{}
+	err = id1.Scan(val)
+	c.Assert(err, IsNil)
+	c.Assert(id1, Equals, id)
+
+	id2 := PrefixedID[userPrefix]{}
+	err = id2.Scan([]byte("usr_3bmyw117dd278r1d00r17x8c68"))
+	c.Assert(err, IsNil)
+	c.Assert(id2, Equals, id)
+
+	err = id2.Scan(42)
+	c.Assert(errors.Is(err, ErrUnsupportedType), Equals, true)
+}
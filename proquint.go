@@ -0,0 +1,105 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"strings"
+)
+
+// Proquint alphabets: consonants encode 4 bits, vowels encode 2 bits.
+const (
+	proquintConsonants = "bdfghjklmnprstvz"
+	proquintVowels     = "aiou"
+)
+
+// Number of letters in proquint representation of UUID:
+// 8 quintuplets of 5 letters each.
+const proquintLetters = 40
+
+// Proquint returns proquint (PRO-nouncable QUINT-uplet) representation
+// of UUID: 8 hyphen-separated groups of 5 letters, each encoding
+// 16 bits, e.g. "kovol-robib-nukot-dalid-mafuh-bagab-huzih-gagam".
+func (u UUID) Proquint() string {
+	buf := make([]byte, 0, proquintLetters+7)
+	for i := 0; i < Size; i += 2 {
+		if i > 0 {
+			buf = append(buf, '-')
+		}
+		w := uint16(u[i])<<8 | uint16(u[i+1])
+		buf = append(buf,
+			proquintConsonants[w>>12],
+			proquintVowels[w>>10&0x03],
+			proquintConsonants[w>>6&0x0f],
+			proquintVowels[w>>4&0x03],
+			proquintConsonants[w&0x0f],
+		)
+	}
+	return string(buf)
+}
+
+// FromProquint returns UUID parsed from proquint representation.
+// Decoding is lenient, so that dictated input can be typed back:
+// letters are case-insensitive, while hyphens and white space
+// are ignored wherever they appear. Malformed input is reported
+// as *ParseError.
+func FromProquint(input string) (UUID, error) {
+	u := UUID{}
+	var w uint16
+	n := 0
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+		if c == '-' || isSpace(c) {
+			continue
+		}
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		if n == proquintLetters {
+			return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+		}
+
+		var v, bits int
+		if n%5%2 == 0 {
+			v, bits = strings.IndexByte(proquintConsonants, c), 4
+		} else {
+			v, bits = strings.IndexByte(proquintVowels, c), 2
+		}
+		if v < 0 {
+			return Nil, &ParseError{Input: input, Offset: i, Reason: ReasonCharacter}
+		}
+
+		w = w<<uint(bits) | uint16(v)
+		n++
+		if n%5 == 0 {
+			u[n/5*2-2] = byte(w >> 8)
+			u[n/5*2-1] = byte(w)
+			w = 0
+		}
+	}
+
+	if n != proquintLetters {
+		return Nil, &ParseError{Input: input, Offset: len(input), Reason: ReasonLength}
+	}
+
+	return u, nil
+}
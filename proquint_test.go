@@ -0,0 +1,90 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type proquintTestSuite struct{}
+
+var _ = Suite(&proquintTestSuite{})
+
+func (s *proquintTestSuite) TestProquint(c *C) {
+	c.Assert(NamespaceDNS.Proquint(), Equals, "kovol-robib-nukot-dalid-mafuh-bagab-huzih-gagam")
+	c.Assert(Nil.Proquint(), Equals, "babab-babab-babab-babab-babab-babab-babab-babab")
+	c.Assert(Max.Proquint(), Equals, "zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz-zuzuz")
+
+	// Test vector from the proquint specification: 127.0.0.1 is "lusab-babad".
+	u := UUID{0x7f, 0x00, 0x00, 0x01}
+	c.Assert(u.Proquint()[:11], Equals, "lusab-babad")
+}
+
+func (s *proquintTestSuite) TestFromProquint(c *C) {
+	inputs := []string{
+		"kovol-robib-nukot-dalid-mafuh-bagab-huzih-gagam",
+		"KOVOL-ROBIB-NUKOT-DALID-MAFUH-BAGAB-HUZIH-GAGAM",
+		"  kovol robib nukot dalid\nmafuh bagab huzih gagam ",
+		"kovolrobibnukotdalidmafuhbagabhuzihgagam",
+		"ko-vol-ro-bib nukot--dalid mafuh bagab huzih gagam",
+	}
+	for _, input := range inputs {
+		u, err := FromProquint(input)
+		c.Assert(err, IsNil, Commentf("%q", input))
+		c.Assert(u, Equals, NamespaceDNS)
+	}
+
+	for i := 0; i < 100; i++ {
+		u, err := NewV4()
+		c.Assert(err, IsNil)
+
+		u1, err := FromProquint(u.Proquint())
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+	}
+}
+
+func (s *proquintTestSuite) TestFromProquintInvalid(c *C) {
+	tests := []struct {
+		input  string
+		offset int
+		reason ParseErrorReason
+	}{
+		{"", 0, ReasonLength},
+		{"kovol-robib-nukot-dalid-mafuh-bagab-huzih", 41, ReasonLength},
+		{"kovol-robib-nukot-dalid-mafuh-bagab-huzih-gagam-b", 49, ReasonLength},
+		{"kovol-robib-nukot-dalid-mafuh-bagab-huzih-gagem", 45, ReasonCharacter},
+		{"aovol-robib-nukot-dalid-mafuh-bagab-huzih-gagam", 0, ReasonCharacter},
+		{"kovol_robib-nukot-dalid-mafuh-bagab-huzih-gagam", 5, ReasonCharacter},
+		{"kovcl-robib-nukot-dalid-mafuh-bagab-huzih-gagam", 3, ReasonCharacter},
+	}
+
+	for _, t := range tests {
+		u, err := FromProquint(t.input)
+		c.Assert(u, Equals, Nil)
+
+		perr, ok := err.(*ParseError)
+		c.Assert(ok, Equals, true, Commentf("%q", t.input))
+		c.Assert(perr.Offset, Equals, t.offset, Commentf("%q", t.input))
+		c.Assert(perr.Reason, Equals, t.reason, Commentf("%q", t.input))
+	}
+}
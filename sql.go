@@ -34,6 +34,7 @@ func (u UUID) Value() (driver.Value, error) {
 // Scan implements the sql.Scanner interface.
 // A 16-byte slice is handled by UnmarshalBinary, while
 // a longer byte slice or a string is handled by UnmarshalText.
+// Source of any other type is reported as ErrUnsupportedType.
 func (u *UUID) Scan(src interface{}) error {
 	switch src := src.(type) {
 	case []byte:
@@ -46,7 +47,7 @@ func (u *UUID) Scan(src interface{}) error {
 		return u.UnmarshalText([]byte(src))
 	}
 
-	return fmt.Errorf("uuid: cannot convert %T to UUID", src)
+	return fmt.Errorf("%w: cannot convert %T to UUID", ErrUnsupportedType, src)
 }
 
 // NullUUID can be used with the standard sql package to represent a
@@ -76,3 +77,52 @@ func (u *NullUUID) Scan(src interface{}) error {
 	u.Valid = true
 	return u.UUID.Scan(src)
 }
+
+// BinaryUUID wraps UUID to be stored in database as 16 raw bytes,
+// e.g. in MySQL BINARY(16) or SQLite BLOB columns.
+type BinaryUUID struct {
+	UUID UUID
+}
+
+// Value implements the driver.Valuer interface.
+// UUID is stored as 16 bytes.
+func (u BinaryUUID) Value() (driver.Value, error) {
+	return u.UUID.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// Both binary and text representations are accepted.
+func (u *BinaryUUID) Scan(src interface{}) error {
+	return u.UUID.Scan(src)
+}
+
+// String returns canonical string representation of UUID.
+func (u BinaryUUID) String() string {
+	return u.UUID.String()
+}
+
+// NullBinaryUUID is the NullUUID counterpart of BinaryUUID:
+// it stores UUID as 16 raw bytes and can be NULL in the database.
+type NullBinaryUUID struct {
+	UUID  UUID
+	Valid bool
+}
+
+// Value implements the driver.Valuer interface.
+func (u NullBinaryUUID) Value() (driver.Value, error) {
+	if !u.Valid {
+		return nil, nil
+	}
+	return u.UUID.Bytes(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (u *NullBinaryUUID) Scan(src interface{}) error {
+	if src == nil {
+		u.UUID, u.Valid = Nil, false
+		return nil
+	}
+
+	u.Valid = true
+	return u.UUID.Scan(src)
+}
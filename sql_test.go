@@ -46,6 +46,12 @@ func (s *sqlTestSuite) TestValueNil(c *C) {
 	c.Assert(val, Equals, Nil.String())
 }
 
+func (s *sqlTestSuite) TestValueMax(c *C) {
+	val, err := Max.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, Equals, "ffffffff-ffff-ffff-ffff-ffffffffffff")
+}
+
 func (s *sqlTestSuite) TestNullUUIDValueNil(c *C) {
 	u := NullUUID{}
 
@@ -101,6 +107,23 @@ func (s *sqlTestSuite) TestScanText(c *C) {
 	c.Assert(err, NotNil)
 }
 
+func (s *sqlTestSuite) TestScanSpecialValues(c *C) {
+	for _, u := range []UUID{Nil, Max} {
+		val, err := u.Value()
+		c.Assert(err, IsNil)
+
+		u1 := UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+		err = u1.Scan(val)
+		c.Assert(err, IsNil)
+		c.Assert(u1, Equals, u)
+
+		u2 := UUID{}
+		err = u2.Scan(u.Bytes())
+		c.Assert(err, IsNil)
+		c.Assert(u2, Equals, u)
+	}
+}
+
 func (s *sqlTestSuite) TestScanUnsupported(c *C) {
 	u := UUID{}
 
@@ -134,3 +157,60 @@ func (s *sqlTestSuite) TestNullUUIDScanNil(c *C) {
 	c.Assert(u.Valid, Equals, false)
 	c.Assert(u.UUID, Equals, Nil)
 }
+
+func (s *sqlTestSuite) TestBinaryUUIDValue(c *C) {
+	val, err := BinaryUUID{NamespaceDNS}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, DeepEquals, NamespaceDNS.Bytes())
+
+	val, err = BinaryUUID{}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, DeepEquals, make([]byte, Size))
+}
+
+func (s *sqlTestSuite) TestBinaryUUIDScan(c *C) {
+	sources := []interface{}{
+		NamespaceDNS.Bytes(),
+		[]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		"6ba7b810-9dad-11d1-80b4-00c04fd430c8",
+	}
+	for _, src := range sources {
+		u := BinaryUUID{}
+		err := u.Scan(src)
+		c.Assert(err, IsNil)
+		c.Assert(u.UUID, Equals, NamespaceDNS)
+		c.Assert(u.String(), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	}
+
+	u := BinaryUUID{}
+	c.Assert(u.Scan(nil), NotNil)
+	c.Assert(u.Scan([]byte{1, 2, 3}), NotNil)
+}
+
+func (s *sqlTestSuite) TestNullBinaryUUIDValue(c *C) {
+	val, err := NullBinaryUUID{}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, IsNil)
+
+	val, err = NullBinaryUUID{UUID: NamespaceDNS, Valid: true}.Value()
+	c.Assert(err, IsNil)
+	c.Assert(val, DeepEquals, NamespaceDNS.Bytes())
+}
+
+func (s *sqlTestSuite) TestNullBinaryUUIDScan(c *C) {
+	u := NullBinaryUUID{}
+	err := u.Scan(NamespaceDNS.Bytes())
+	c.Assert(err, IsNil)
+	c.Assert(u.Valid, Equals, true)
+	c.Assert(u.UUID, Equals, NamespaceDNS)
+
+	err = u.Scan("6ba7b811-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(u.Valid, Equals, true)
+	c.Assert(u.UUID, Equals, NamespaceURL)
+
+	err = u.Scan(nil)
+	c.Assert(err, IsNil)
+	c.Assert(u.Valid, Equals, false)
+	c.Assert(u.UUID, Equals, Nil)
+}
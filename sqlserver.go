@@ -0,0 +1,58 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"sort"
+)
+
+// Order in which SQL Server compares uniqueidentifier bytes
+// stored in Microsoft GUID layout.
+var sqlServerOrder = [Size]int{10, 11, 12, 13, 14, 15, 8, 9, 6, 7, 4, 5, 0, 1, 2, 3}
+
+// CompareSQLServer returns an integer comparing u and v the way SQL Server
+// orders uniqueidentifier values: by the last 6 bytes first, then by bytes
+// 8-9, then by the Data3, Data2 and Data1 fields in little-endian byte order.
+// The result will be 0 if u == v, -1 if u < v and +1 if u > v.
+func CompareSQLServer(u, v UUID) int {
+	gu, gv := u.swapGUID(), v.swapGUID()
+	for _, i := range sqlServerOrder {
+		switch {
+		case gu[i] < gv[i]:
+			return -1
+		case gu[i] > gv[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// SQLServerSlice attaches the methods of sort.Interface to []UUID,
+// sorting in increasing order as defined by CompareSQLServer.
+type SQLServerSlice []UUID
+
+func (s SQLServerSlice) Len() int           { return len(s) }
+func (s SQLServerSlice) Less(i, j int) bool { return CompareSQLServer(s[i], s[j]) < 0 }
+func (s SQLServerSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+
+// Sort is a convenience method.
+func (s SQLServerSlice) Sort() { sort.Sort(s) }
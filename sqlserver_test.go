@@ -0,0 +1,147 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"crypto/rand"
+	"sort"
+	"time"
+
+	. "gopkg.in/check.v1"
+)
+
+type sqlServerTestSuite struct{}
+
+var _ = Suite(&sqlServerTestSuite{})
+
+func (s *sqlServerTestSuite) TestCompareSQLServer(c *C) {
+	// Values in ascending SQL Server order.
+	ordered := []UUID{
+		Must(FromString("01000000-0000-0000-0000-000000000000")),
+		Must(FromString("00010000-0000-0000-0000-000000000000")),
+		Must(FromString("00000100-0000-0000-0000-000000000000")),
+		Must(FromString("00000001-0000-0000-0000-000000000000")),
+		Must(FromString("00000000-0100-0000-0000-000000000000")),
+		Must(FromString("00000000-0001-0000-0000-000000000000")),
+		Must(FromString("00000000-0000-0100-0000-000000000000")),
+		Must(FromString("00000000-0000-0001-0000-000000000000")),
+		Must(FromString("00000000-0000-0000-0001-000000000000")),
+		Must(FromString("00000000-0000-0000-0100-000000000000")),
+		Must(FromString("00000000-0000-0000-0000-000000000001")),
+		Must(FromString("00000000-0000-0000-0000-000000000100")),
+		Must(FromString("00000000-0000-0000-0000-000000010000")),
+		Must(FromString("00000000-0000-0000-0000-000001000000")),
+		Must(FromString("00000000-0000-0000-0000-000100000000")),
+		Must(FromString("00000000-0000-0000-0000-010000000000")),
+	}
+
+	for i := range ordered {
+		c.Assert(CompareSQLServer(ordered[i], ordered[i]), Equals, 0)
+		for j := i + 1; j < len(ordered); j++ {
+			c.Assert(CompareSQLServer(ordered[i], ordered[j]), Equals, -1, Commentf("%s %s", ordered[i], ordered[j]))
+			c.Assert(CompareSQLServer(ordered[j], ordered[i]), Equals, 1)
+		}
+	}
+
+	shuffled := SQLServerSlice{ordered[7], ordered[15], ordered[0], ordered[9], ordered[3], ordered[12], ordered[1], ordered[14],
+		ordered[5], ordered[10], ordered[2], ordered[13], ordered[8], ordered[4], ordered[11], ordered[6]}
+	shuffled.Sort()
+	c.Assert([]UUID(shuffled), DeepEquals, ordered)
+}
+
+func (s *sqlServerTestSuite) TestNewCOMB(c *C) {
+	u1, err := NewCOMB()
+	c.Assert(err, IsNil)
+	c.Assert(u1.Version(), Equals, V4)
+	c.Assert(u1.Variant(), Equals, VariantRFC4122)
+
+	u2, err := NewCOMB()
+	c.Assert(err, IsNil)
+	c.Assert(u1, Not(Equals), u2)
+	c.Assert(CompareSQLServer(u1, u2), Equals, -1)
+}
+
+func (s *sqlServerTestSuite) TestNewCOMBOrdering(c *C) {
+	var ticks int64
+	g := &rfc4122Generator{
+		epochFunc: func() time.Time {
+			// Clock advances every 1000 calls and goes back once.
+			ticks++
+			if ticks == 5000 {
+				return time.Unix(0, 0)
+			}
+			return time.Unix(1500000000, ticks/1000*int64(time.Millisecond))
+		},
+		hwAddrFunc: defaultHWAddrFunc,
+		rand:       rand.Reader,
+	}
+
+	generated := make([]UUID, 20000)
+	for i := range generated {
+		u, err := g.NewCOMB()
+		c.Assert(err, IsNil)
+		generated[i] = u
+	}
+
+	c.Assert(sort.IsSorted(SQLServerSlice(generated)), Equals, true)
+	for i := 1; i < len(generated); i++ {
+		c.Assert(generated[i-1], Not(Equals), generated[i])
+	}
+}
+
+func (s *sqlServerTestSuite) TestNewCOMBSequenceOverflow(c *C) {
+	g := &rfc4122Generator{
+		epochFunc: func() time.Time {
+			return time.Unix(1500000000, 0)
+		},
+		hwAddrFunc: defaultHWAddrFunc,
+		rand:       rand.Reader,
+	}
+
+	generated := make([]UUID, 0x4001*2)
+	for i := range generated {
+		u, err := g.NewCOMB()
+		c.Assert(err, IsNil)
+		generated[i] = u
+	}
+
+	c.Assert(sort.IsSorted(SQLServerSlice(generated)), Equals, true)
+	c.Assert(generated[0x4000][10:], DeepEquals, generated[0x4001][10:])
+	c.Assert(generated[0x3fff][10:], Not(DeepEquals), generated[0x4000][10:])
+}
+
+func (s *sqlServerTestSuite) TestNewCOMBFaultyRand(c *C) {
+	g := &rfc4122Generator{
+		epochFunc:  time.Now,
+		hwAddrFunc: defaultHWAddrFunc,
+		rand:       &faultyReader{},
+	}
+	u, err := g.NewCOMB()
+	c.Assert(err, NotNil)
+	c.Assert(u, Equals, Nil)
+}
+
+func (s *sqlServerTestSuite) BenchmarkNewCOMB(c *C) {
+	for i := 0; i < c.N; i++ {
+		NewCOMB()
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Storage describes how UUID is stored in database column.
+type Storage int
+
+// UUID storage kinds.
+const (
+	// StorageAuto accepts both text and binary values,
+	// same as UUID.Scan does.
+	StorageAuto Storage = iota
+	// StorageText accepts text representation only.
+	StorageText
+	// StorageBinary accepts 16 raw bytes only.
+	StorageBinary
+	// StorageGUIDBinary accepts 16 raw bytes in Microsoft GUID layout only.
+	StorageGUIDBinary
+)
+
+// String returns name of storage kind.
+func (s Storage) String() string {
+	switch s {
+	case StorageAuto:
+		return "auto"
+	case StorageText:
+		return "text"
+	case StorageBinary:
+		return "binary"
+	case StorageGUIDBinary:
+		return "guid-binary"
+	}
+	return fmt.Sprintf("Storage(%d)", int(s))
+}
+
+// Returns UUID decoded from src according to storage kind.
+func (s Storage) scan(src interface{}) (u UUID, err error) {
+	switch s {
+	case StorageAuto:
+		err = u.Scan(src)
+
+	case StorageText:
+		switch src := src.(type) {
+		case string:
+			err = u.UnmarshalText([]byte(src))
+		case []byte:
+			err = u.UnmarshalText(src)
+		default:
+			return Nil, fmt.Errorf("%w: cannot convert %T to UUID with %s storage", ErrUnsupportedType, src, s)
+		}
+
+	case StorageBinary, StorageGUIDBinary:
+		b, ok := src.([]byte)
+		if !ok {
+			return Nil, fmt.Errorf("%w: cannot convert %T to UUID with %s storage", ErrUnsupportedType, src, s)
+		}
+		if s == StorageGUIDBinary {
+			u, err = FromGUIDBytes(b)
+		} else {
+			err = u.UnmarshalBinary(b)
+		}
+
+	default:
+		return Nil, fmt.Errorf("uuid: unknown storage %s", s)
+	}
+
+	if err != nil {
+		return Nil, fmt.Errorf("uuid: cannot scan UUID with %s storage: %w", s, err)
+	}
+	return u, nil
+}
+
+// StoredUUID wraps UUID to be scanned from and stored in database
+// column with explicitly configured storage kind. Unlike UUID.Scan,
+// which guesses representation by its length, scanning value of
+// unexpected representation results in error:
+//
+//	u := uuid.StoredUUID{Storage: uuid.StorageBinary}
+//	err := row.Scan(&u)
+type StoredUUID struct {
+	UUID    UUID
+	Storage Storage
+}
+
+// Value implements the driver.Valuer interface.
+// UUID is stored as 16 bytes for binary storage kinds
+// and as canonical string otherwise.
+func (s StoredUUID) Value() (driver.Value, error) {
+	switch s.Storage {
+	case StorageAuto, StorageText:
+		return s.UUID.String(), nil
+	case StorageBinary:
+		return s.UUID.Bytes(), nil
+	case StorageGUIDBinary:
+		return s.UUID.GUIDBytes(), nil
+	}
+	return nil, fmt.Errorf("uuid: unknown storage %s", s.Storage)
+}
+
+// Scan implements the sql.Scanner interface.
+// UUID is left unchanged on error.
+func (s *StoredUUID) Scan(src interface{}) error {
+	u, err := s.Storage.scan(src)
+	if err != nil {
+		return err
+	}
+	s.UUID = u
+	return nil
+}
+
+// String returns canonical string representation of UUID.
+func (s StoredUUID) String() string {
+	return s.UUID.String()
+}
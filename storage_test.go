@@ -0,0 +1,116 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"errors"
+
+	. "gopkg.in/check.v1"
+)
+
+type storageTestSuite struct{}
+
+var _ = Suite(&storageTestSuite{})
+
+func (s *storageTestSuite) TestString(c *C) {
+	c.Assert(StorageAuto.String(), Equals, "auto")
+	c.Assert(StorageText.String(), Equals, "text")
+	c.Assert(StorageBinary.String(), Equals, "binary")
+	c.Assert(StorageGUIDBinary.String(), Equals, "guid-binary")
+	c.Assert(Storage(42).String(), Equals, "Storage(42)")
+}
+
+func (s *storageTestSuite) TestValue(c *C) {
+	tests := []struct {
+		storage Storage
+		val     interface{}
+	}{
+		{StorageAuto, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{StorageText, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{StorageBinary, NamespaceDNS.Bytes()},
+		{StorageGUIDBinary, guidDNS},
+	}
+	for _, t := range tests {
+		val, err := StoredUUID{NamespaceDNS, t.storage}.Value()
+		c.Assert(err, IsNil)
+		c.Assert(val, DeepEquals, t.val, Commentf("%s", t.storage))
+	}
+
+	_, err := StoredUUID{NamespaceDNS, Storage(42)}.Value()
+	c.Assert(err, NotNil)
+}
+
+func (s *storageTestSuite) TestScan(c *C) {
+	tests := []struct {
+		storage Storage
+		src     interface{}
+	}{
+		{StorageAuto, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{StorageAuto, NamespaceDNS.Bytes()},
+		{StorageText, "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		{StorageText, []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8")},
+		{StorageText, "{6ba7b810-9dad-11d1-80b4-00c04fd430c8}"},
+		{StorageBinary, NamespaceDNS.Bytes()},
+		{StorageGUIDBinary, guidDNS},
+	}
+	for _, t := range tests {
+		u := StoredUUID{Storage: t.storage}
+		err := u.Scan(t.src)
+		c.Assert(err, IsNil, Commentf("%s %v", t.storage, t.src))
+		c.Assert(u.UUID, Equals, NamespaceDNS)
+		c.Assert(u.String(), Equals, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	}
+}
+
+func (s *storageTestSuite) TestScanMismatch(c *C) {
+	tests := []struct {
+		storage Storage
+		src     interface{}
+		target  error
+	}{
+		{StorageText, NamespaceDNS.Bytes(), ErrInvalidLength},
+		{StorageText, []byte("0123456789abcdef"), ErrInvalidLength},
+		{StorageText, 42, ErrUnsupportedType},
+		{StorageText, nil, ErrUnsupportedType},
+		{StorageBinary, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ErrUnsupportedType},
+		{StorageBinary, []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ErrInvalidLength},
+		{StorageBinary, nil, ErrUnsupportedType},
+		{StorageGUIDBinary, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", ErrUnsupportedType},
+		{StorageGUIDBinary, []byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), ErrInvalidLength},
+		{StorageAuto, nil, ErrUnsupportedType},
+	}
+	for _, t := range tests {
+		u := StoredUUID{UUID: NamespaceURL, Storage: t.storage}
+		err := u.Scan(t.src)
+		c.Assert(err, NotNil, Commentf("%s %v", t.storage, t.src))
+		c.Assert(errors.Is(err, t.target), Equals, true, Commentf("%s %v: %v", t.storage, t.src, err))
+		c.Assert(u.UUID, Equals, NamespaceURL)
+	}
+
+	u := StoredUUID{Storage: StorageBinary}
+	err := u.Scan([]byte("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
+	c.Assert(err, ErrorMatches, "uuid: cannot scan UUID with binary storage: .*")
+
+	u = StoredUUID{Storage: Storage(42)}
+	err = u.Scan(NamespaceDNS.Bytes())
+	c.Assert(err, ErrorMatches, "uuid: unknown storage Storage\\(42\\)")
+}
@@ -26,7 +26,6 @@ package uuid
 
 import (
 	"bytes"
-	"encoding/hex"
 )
 
 // Size of a UUID in bytes.
@@ -67,10 +66,36 @@ var (
 	byteGroups = []int{8, 4, 4, 4, 12}
 )
 
+// Hex encoding helpers.
+const hexDigits = "0123456789abcdef"
+
+// hexTable maps hex characters to their values and
+// any other byte to 0xff.
+var hexTable = func() (t [256]byte) {
+	for i := range t {
+		t[i] = 0xff
+	}
+	for i := byte(0); i < 10; i++ {
+		t['0'+i] = i
+	}
+	for i := byte(0); i < 6; i++ {
+		t['a'+i] = 10 + i
+		t['A'+i] = 10 + i
+	}
+	return
+}()
+
 // Nil is special form of UUID that is specified to have all
 // 128 bits set to zero.
 var Nil = UUID{}
 
+// Max is special form of UUID that is specified to have all
+// 128 bits set to one (as specified in RFC 9562).
+var Max = UUID{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+}
+
 // Predefined namespace UUIDs.
 var (
 	NamespaceDNS  = Must(FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"))
@@ -84,12 +109,25 @@ func Equal(u1 UUID, u2 UUID) bool {
 	return bytes.Equal(u1[:], u2[:])
 }
 
+// IsNil returns true if UUID is the Nil UUID, otherwise returns false.
+func (u UUID) IsNil() bool {
+	return u == Nil
+}
+
+// IsMax returns true if UUID is the Max UUID, otherwise returns false.
+func (u UUID) IsMax() bool {
+	return u == Max
+}
+
 // Version returns algorithm version used to generate UUID.
+// Version of Nil UUID is 0 and version of Max UUID is 15,
+// neither of which denotes a generation algorithm.
 func (u UUID) Version() byte {
 	return u[6] >> 4
 }
 
 // Variant returns UUID layout variant.
+// Nil UUID is reported as VariantNCS and Max UUID as VariantFuture.
 func (u UUID) Variant() byte {
 	switch {
 	case (u[8] >> 7) == 0x00:
@@ -113,19 +151,10 @@ func (u UUID) Bytes() []byte {
 // Returns canonical string representation of UUID:
 // xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx.
 func (u UUID) String() string {
-	buf := make([]byte, 36)
-
-	hex.Encode(buf[0:8], u[0:4])
-	buf[8] = '-'
-	hex.Encode(buf[9:13], u[4:6])
-	buf[13] = '-'
-	hex.Encode(buf[14:18], u[6:8])
-	buf[18] = '-'
-	hex.Encode(buf[19:23], u[8:10])
-	buf[23] = '-'
-	hex.Encode(buf[24:], u[10:])
-
-	return string(buf)
+	var buf [36]byte
+	encodeCanonical(buf[:], u)
+
+	return string(buf[:])
 }
 
 // SetVersion sets version bits.
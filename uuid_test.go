@@ -98,3 +98,25 @@ func (s *testSuite) TestMust(c *C) {
 		return Nil, fmt.Errorf("uuid: expected error")
 	}())
 }
+
+func (s *testSuite) TestIsNil(c *C) {
+	c.Assert(Nil.IsNil(), Equals, true)
+	c.Assert(Max.IsNil(), Equals, false)
+	c.Assert(NamespaceDNS.IsNil(), Equals, false)
+}
+
+func (s *testSuite) TestIsMax(c *C) {
+	c.Assert(Max.IsMax(), Equals, true)
+	c.Assert(Nil.IsMax(), Equals, false)
+	c.Assert(NamespaceDNS.IsMax(), Equals, false)
+}
+
+func (s *testSuite) TestSpecialValues(c *C) {
+	c.Assert(Nil.String(), Equals, "00000000-0000-0000-0000-000000000000")
+	c.Assert(Nil.Version(), Equals, byte(0))
+	c.Assert(Nil.Variant(), Equals, VariantNCS)
+
+	c.Assert(Max.String(), Equals, "ffffffff-ffff-ffff-ffff-ffffffffffff")
+	c.Assert(Max.Version(), Equals, byte(15))
+	c.Assert(Max.Variant(), Equals, VariantFuture)
+}
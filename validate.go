@@ -0,0 +1,155 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	"fmt"
+)
+
+// ValidationRule identifies a rule enforced by Validator.
+type ValidationRule int
+
+// Validation rules.
+const (
+	RuleCanonical ValidationRule = iota + 1
+	RuleNotNil
+	RuleVariant
+	RuleVersion
+)
+
+// String returns name of validation rule.
+func (r ValidationRule) String() string {
+	switch r {
+	case RuleCanonical:
+		return "canonical"
+	case RuleNotNil:
+		return "not-nil"
+	case RuleVariant:
+		return "variant"
+	case RuleVersion:
+		return "version"
+	default:
+		return fmt.Sprintf("ValidationRule(%d)", int(r))
+	}
+}
+
+// ValidationError is returned by Validator when UUID breaks one of
+// the enforced rules.
+type ValidationError struct {
+	Rule  ValidationRule
+	UUID  UUID
+	Input string // original text input, empty when UUID was validated directly
+}
+
+// Error implements the error interface.
+func (e *ValidationError) Error() string {
+	switch e.Rule {
+	case RuleCanonical:
+		return fmt.Sprintf("uuid: %q is not in canonical format", e.Input)
+	case RuleNotNil:
+		return "uuid: Nil UUID is not allowed"
+	case RuleVariant:
+		return fmt.Sprintf("uuid: %s has non RFC 4122 variant", e.UUID)
+	case RuleVersion:
+		return fmt.Sprintf("uuid: %s has disallowed version %d", e.UUID, e.UUID.Version())
+	default:
+		return fmt.Sprintf("uuid: %s failed %s rule", e.UUID, e.Rule)
+	}
+}
+
+// Validator checks UUIDs against a set of rules.
+// Zero value accepts any UUID.
+type Validator struct {
+	// Versions lists allowed UUID versions. Any version is allowed if empty.
+	Versions []byte
+
+	// RequireRFC4122 rejects UUIDs of variant other than VariantRFC4122.
+	RequireRFC4122 bool
+
+	// RejectNil rejects Nil UUID.
+	RejectNil bool
+
+	// RequireCanonical rejects text input which is not in lower-case
+	// canonical format "6ba7b810-9dad-11d1-80b4-00c04fd430c8".
+	RequireCanonical bool
+}
+
+// Validate returns *ValidationError if u breaks any of the rules,
+// otherwise returns nil. RequireCanonical rule is not applied, since
+// it concerns text input only.
+func (v Validator) Validate(u UUID) error {
+	if v.RejectNil && u == Nil {
+		return &ValidationError{Rule: RuleNotNil, UUID: u}
+	}
+
+	if v.RequireRFC4122 && u.Variant() != VariantRFC4122 {
+		return &ValidationError{Rule: RuleVariant, UUID: u}
+	}
+
+	if len(v.Versions) > 0 && !v.allowsVersion(u.Version()) {
+		return &ValidationError{Rule: RuleVersion, UUID: u}
+	}
+
+	return nil
+}
+
+// FromString returns UUID parsed from string input and checked
+// against all the rules. Input is expected in a form accepted by
+// UnmarshalText, unless RequireCanonical is set.
+func (v Validator) FromString(input string) (UUID, error) {
+	u, err := FromString(input)
+	if err != nil {
+		return Nil, err
+	}
+
+	if v.RequireCanonical && u.String() != input {
+		return Nil, &ValidationError{Rule: RuleCanonical, UUID: u, Input: input}
+	}
+
+	if err = v.Validate(u); err != nil {
+		err.(*ValidationError).Input = input
+		return Nil, err
+	}
+
+	return u, nil
+}
+
+// Returns true if version is allowed.
+func (v Validator) allowsVersion(version byte) bool {
+	for _, allowed := range v.Versions {
+		if allowed == version {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRFC4122 is a helper that returns nil if u is a non-Nil UUID
+// of VariantRFC4122 and one of given versions (any version if none given),
+// otherwise returns *ValidationError.
+func ValidateRFC4122(u UUID, versions ...byte) error {
+	return Validator{
+		Versions:       versions,
+		RequireRFC4122: true,
+		RejectNil:      true,
+	}.Validate(u)
+}
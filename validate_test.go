@@ -0,0 +1,131 @@
+// Copyright (C) 2013-2018 by Maxim Bublis <b@codemonkey.ru>
+//
+// Permission is hereby granted, free of charge, to any person obtaining
+// a copy of this software and associated documentation files (the
+// "Software"), to deal in the Software without restriction, including
+// without limitation the rights to use, copy, modify, merge, publish,
+// distribute, sublicense, and/or sell copies of the Software, and to
+// permit persons to whom the Software is furnished to do so, subject to
+// the following conditions:
+//
+// The above copyright notice and this permission notice shall be
+// included in all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,
+// EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND
+// NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE
+// LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION
+// OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION
+// WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+package uuid
+
+import (
+	. "gopkg.in/check.v1"
+)
+
+type validateTestSuite struct{}
+
+var _ = Suite(&validateTestSuite{})
+
+func (s *validateTestSuite) TestValidateZero(c *C) {
+	v := Validator{}
+
+	c.Assert(v.Validate(Nil), IsNil)
+	c.Assert(v.Validate(Max), IsNil)
+	c.Assert(v.Validate(NamespaceDNS), IsNil)
+}
+
+func (s *validateTestSuite) TestValidateRules(c *C) {
+	v := Validator{
+		Versions:       []byte{V4, V5},
+		RequireRFC4122: true,
+		RejectNil:      true,
+	}
+
+	c.Assert(v.Validate(Must(NewV4())), IsNil)
+	c.Assert(v.Validate(NewV5(NamespaceDNS, "www.example.com")), IsNil)
+
+	tests := []struct {
+		u    UUID
+		rule ValidationRule
+	}{
+		{Nil, RuleNotNil},
+		{Max, RuleVariant},
+		{UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x41, 0xd1, 0xc0, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}, RuleVariant},
+		{NamespaceDNS, RuleVersion},
+		{NewV3(NamespaceDNS, "www.example.com"), RuleVersion},
+	}
+
+	for _, t := range tests {
+		err := v.Validate(t.u)
+		c.Assert(err, NotNil)
+
+		verr, ok := err.(*ValidationError)
+		c.Assert(ok, Equals, true)
+		c.Assert(verr.Rule, Equals, t.rule)
+		c.Assert(verr.UUID, Equals, t.u)
+		c.Assert(verr.Error(), Not(Equals), "")
+	}
+}
+
+func (s *validateTestSuite) TestValidatorFromString(c *C) {
+	v := Validator{
+		Versions:         []byte{V1},
+		RequireRFC4122:   true,
+		RejectNil:        true,
+		RequireCanonical: true,
+	}
+
+	u, err := v.FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+
+	inputs := []struct {
+		input string
+		rule  ValidationRule
+	}{
+		{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8", RuleCanonical},
+		{"{6ba7b810-9dad-11d1-80b4-00c04fd430c8}", RuleCanonical},
+		{"6ba7b8109dad11d180b400c04fd430c8", RuleCanonical},
+		{"00000000-0000-0000-0000-000000000000", RuleNotNil},
+		{"6ba7b810-9dad-11d1-c0b4-00c04fd430c8", RuleVariant},
+		{"6ba7b810-9dad-41d1-80b4-00c04fd430c8", RuleVersion},
+	}
+
+	for _, t := range inputs {
+		u, err := v.FromString(t.input)
+		c.Assert(u, Equals, Nil)
+
+		verr, ok := err.(*ValidationError)
+		c.Assert(ok, Equals, true, Commentf("%s", t.input))
+		c.Assert(verr.Rule, Equals, t.rule)
+		c.Assert(verr.Input, Equals, t.input)
+	}
+
+	_, err = v.FromString("6ba7b810-9dad-11d1-80b4-00c04fd430c")
+	c.Assert(err, NotNil)
+	_, ok := err.(*ValidationError)
+	c.Assert(ok, Equals, false)
+
+	u, err = Validator{}.FromString("{6ba7b810-9dad-11d1-80b4-00c04fd430c8}")
+	c.Assert(err, IsNil)
+	c.Assert(u, Equals, NamespaceDNS)
+}
+
+func (s *validateTestSuite) TestValidateRFC4122(c *C) {
+	c.Assert(ValidateRFC4122(Must(NewV4())), IsNil)
+	c.Assert(ValidateRFC4122(Must(NewV4()), V4), IsNil)
+	c.Assert(ValidateRFC4122(Must(NewV4()), V1, V5), NotNil)
+	c.Assert(ValidateRFC4122(Nil), NotNil)
+	c.Assert(ValidateRFC4122(Max), NotNil)
+}
+
+func (s *validateTestSuite) TestValidationRuleString(c *C) {
+	c.Assert(RuleCanonical.String(), Equals, "canonical")
+	c.Assert(RuleNotNil.String(), Equals, "not-nil")
+	c.Assert(RuleVariant.String(), Equals, "variant")
+	c.Assert(RuleVersion.String(), Equals, "version")
+	c.Assert(ValidationRule(0).String(), Equals, "ValidationRule(0)")
+}